@@ -1,6 +1,6 @@
 module github.com/joanlopez/go-lichess
 
-go 1.20
+go 1.23
 
 require (
 	github.com/google/go-querystring v1.1.0
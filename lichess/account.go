@@ -0,0 +1,168 @@
+package lichess
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// AccountService handles communication with the account related
+// methods of the Lichess API. All of them require authentication.
+//
+// Lichess API docs: https://lichess.org/api#tag/Account
+type AccountService service
+
+// AccountPreferences represents the preferences of the authenticated user,
+// along with the language of the account.
+type AccountPreferences struct {
+	Prefs    *Preferences `json:"prefs,omitempty"`
+	Language string       `json:"language,omitempty"`
+}
+
+// Preferences represents the preferences of a Lichess user.
+// Most of the numeric values are enumerations, whose meaning
+// is described at https://lichess.org/api#tag/Account/operation/account.
+type Preferences struct {
+	Dark          bool   `json:"dark,omitempty"`
+	Transp        bool   `json:"transp,omitempty"`
+	BgImg         string `json:"bgImg,omitempty"`
+	Is3d          bool   `json:"is3d,omitempty"`
+	Theme         string `json:"theme,omitempty"`
+	PieceSet      string `json:"pieceSet,omitempty"`
+	Theme3d       string `json:"theme3d,omitempty"`
+	PieceSet3d    string `json:"pieceSet3d,omitempty"`
+	SoundSet      string `json:"soundSet,omitempty"`
+	Blindfold     int    `json:"blindfold,omitempty"`
+	AutoQueen     int    `json:"autoQueen,omitempty"`
+	AutoThreefold int    `json:"autoThreefold,omitempty"`
+	Takeback      int    `json:"takeback,omitempty"`
+	Moretime      int    `json:"moretime,omitempty"`
+	ClockTenths   int    `json:"clockTenths,omitempty"`
+	ClockBar      bool   `json:"clockBar,omitempty"`
+	ClockSound    bool   `json:"clockSound,omitempty"`
+	Premove       bool   `json:"premove,omitempty"`
+	Animation     int    `json:"animation,omitempty"`
+	Captured      bool   `json:"captured,omitempty"`
+	Follow        bool   `json:"follow,omitempty"`
+	Highlight     bool   `json:"highlight,omitempty"`
+	Destination   bool   `json:"destination,omitempty"`
+	Coords        int    `json:"coords,omitempty"`
+	Replay        int    `json:"replay,omitempty"`
+	Challenge     int    `json:"challenge,omitempty"`
+	Message       int    `json:"message,omitempty"`
+	CoordColor    int    `json:"coordColor,omitempty"`
+	SubmitMove    int    `json:"submitMove,omitempty"`
+	ConfirmResign int    `json:"confirmResign,omitempty"`
+	InsightShare  int    `json:"insightShare,omitempty"`
+	KeyboardMove  int    `json:"keyboardMove,omitempty"`
+	Zen           int    `json:"zen,omitempty"`
+	MoveEvent     int    `json:"moveEvent,omitempty"`
+	RookCastle    int    `json:"rookCastle,omitempty"`
+}
+
+// GetAccount gets the public profile of the authenticated user.
+// Find more details at https://lichess.org/api#tag/Account/operation/accountMe.
+func (s *AccountService) GetAccount(ctx context.Context) (*User, *Response, error) {
+	u := "api/account"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user *User
+	resp, err := s.client.Do(req, &user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+// GetEmail gets the email address of the authenticated user.
+// It requires the email:read scope.
+// Find more details at https://lichess.org/api#tag/Account/operation/accountEmail.
+func (s *AccountService) GetEmail(ctx context.Context) (string, *Response, error) {
+	u := "api/account/email"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var email struct {
+		Email string `json:"email"`
+	}
+	resp, err := s.client.Do(req, &email)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return email.Email, resp, nil
+}
+
+// GetPreferences gets the preferences of the authenticated user.
+// It requires the preference:read scope.
+// Find more details at https://lichess.org/api#tag/Account/operation/account.
+func (s *AccountService) GetPreferences(ctx context.Context) (*AccountPreferences, *Response, error) {
+	u := "api/account/preferences"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var prefs *AccountPreferences
+	resp, err := s.client.Do(req, &prefs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return prefs, resp, nil
+}
+
+// GetKidMode gets whether the kid mode is enabled for the authenticated user.
+// It requires the preference:read scope.
+// Find more details at https://lichess.org/api#tag/Account/operation/accountKid.
+func (s *AccountService) GetKidMode(ctx context.Context) (bool, *Response, error) {
+	u := "api/account/kid"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var kid struct {
+		Kid bool `json:"kid"`
+	}
+	resp, err := s.client.Do(req, &kid)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return kid.Kid, resp, nil
+}
+
+// setKidModeOptions specifies parameters for
+// AccountService.SetKidMode method.
+type setKidModeOptions struct {
+	V bool `url:"v"`
+}
+
+// SetKidMode enables or disables the kid mode for the authenticated user.
+// It requires the preference:write scope.
+// Find more details at https://lichess.org/api#tag/Account/operation/accountKidPost.
+func (s *AccountService) SetKidMode(ctx context.Context, enabled bool) (*Response, error) {
+	u, err := addOptions("api/account/kid", setKidModeOptions{V: enabled})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
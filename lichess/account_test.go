@@ -0,0 +1,112 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestAccountService_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer lip_token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"id":"chucknorris","username":"ChuckNorris","followable":true}`))
+	})
+
+	user, _, err := client.WithAuthToken("lip_token").Account.GetAccount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "chucknorris", user.Id)
+	assert.True(t, user.Followable)
+}
+
+func TestAccountService_GetAccount_unauthorized(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"No such token"}`))
+	})
+
+	user, _, err := client.Account.GetAccount(context.Background())
+	require.ErrorIs(t, err, lichess.ErrUnauthorized)
+	assert.Nil(t, user)
+}
+
+func TestAccountService_GetEmail(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account/email", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"email":"chuck@norris.com"}`))
+	})
+
+	email, _, err := client.Account.GetEmail(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "chuck@norris.com", email)
+}
+
+func TestAccountService_GetPreferences(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account/preferences", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"prefs":{"dark":true,"pieceSet":"cburnett","autoQueen":2,"zen":1},"language":"en-GB"}`))
+	})
+
+	prefs, _, err := client.Account.GetPreferences(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "en-GB", prefs.Language)
+	require.NotNil(t, prefs.Prefs)
+	assert.True(t, prefs.Prefs.Dark)
+	assert.Equal(t, "cburnett", prefs.Prefs.PieceSet)
+	assert.Equal(t, 2, prefs.Prefs.AutoQueen)
+	assert.Equal(t, 1, prefs.Prefs.Zen)
+}
+
+func TestAccountService_GetKidMode(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account/kid", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"kid":true}`))
+	})
+
+	kid, _, err := client.Account.GetKidMode(context.Background())
+	require.NoError(t, err)
+	assert.True(t, kid)
+}
+
+func TestAccountService_SetKidMode(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		enabled bool
+		wantV   string
+	}{
+		"enable":  {enabled: true, wantV: "true"},
+		"disable": {enabled: false, wantV: "false"},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc("POST /api/account/kid", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.wantV, r.URL.Query().Get("v"))
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			})
+
+			_, err := client.Account.SetKidMode(context.Background(), tc.enabled)
+			require.NoError(t, err)
+		})
+	}
+}
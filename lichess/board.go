@@ -0,0 +1,194 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// BoardService handles communication with the Board API, used to play games
+// with a physical or custom board, or a third-party client. All of its methods
+// require authentication, with the board:play scope.
+//
+// Lichess API docs: https://lichess.org/api#tag/Board
+type BoardService service
+
+// StreamEvents streams the [IncomingEvent] of the authenticated user,
+// like games starting or finishing and incoming challenges. The games in
+// progress and the pending challenges are sent first. Events that aren't
+// recognized (yet) by this library are sent as [UnknownIncomingEvent].
+// It closes the channel of [IncomingEvent] and the [Response] body when the context is done.
+// So, please use the [context.Context] argument to control the lifetime of the stream.
+// If the stream breaks (e.g. a malformed or truncated line, or a connection error),
+// an [IncomingEventError] is sent as the last event before closing the channel,
+// unless the client has a [Client.ReconnectPolicy], in which case the stream is
+// transparently resumed, and the games and challenges already sent are skipped.
+// Find more details at https://lichess.org/api#tag/Board/operation/apiStreamEvent.
+func (s *BoardService) StreamEvents(ctx context.Context) (chan IncomingEvent, *Response, error) {
+	return streamIncomingEvents(ctx, s.client)
+}
+
+// Events returns an iterator over the [IncomingEvent] of the authenticated user.
+// Equivalent to [BoardService.StreamEvents] but with range-over-func semantics.
+func (s *BoardService) Events(ctx context.Context) iter.Seq2[IncomingEvent, error] {
+	return incomingEventsSeq(ctx, s.client)
+}
+
+// StreamGame streams the [GameStateEvent] happening at the game identified by id,
+// which must be played by the authenticated user. The first event is a [GameFull].
+// It closes the channel of [GameStateEvent] and the [Response] body when the context is done.
+// So, please use the [context.Context] argument to control the lifetime of the stream.
+// If the stream breaks (e.g. a malformed or truncated line, or a connection error),
+// a [GameStateEventError] is sent as the last event before closing the channel,
+// unless the client has a [Client.ReconnectPolicy], in which case the stream is
+// transparently resumed, starting with a [GameFull] again.
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameStream.
+func (s *BoardService) StreamGame(ctx context.Context, id string) (chan GameStateEvent, *Response, error) {
+	return streamGameState(ctx, s.client, func() (*http.Request, error) {
+		return s.gameRequest(ctx, id)
+	})
+}
+
+// GameEvents returns an iterator over the [GameStateEvent] happening at the game identified by id.
+// Equivalent to [BoardService.StreamGame] but with range-over-func semantics.
+func (s *BoardService) GameEvents(ctx context.Context, id string) iter.Seq2[GameStateEvent, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.gameRequest(ctx, id)
+	}, parseGameStateEvent)
+}
+
+func (s *BoardService) gameRequest(ctx context.Context, id string) (*http.Request, error) {
+	u := fmt.Sprintf("api/board/game/stream/%v", id)
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
+
+// MakeMoveOptions specifies parameters for BoardService.MakeMove method.
+type MakeMoveOptions struct {
+	// OfferingDraw offers (or agrees to) a draw, along with the move.
+	OfferingDraw *bool `url:"offeringDraw,omitempty"`
+}
+
+// MakeMove plays a move, in UCI format (e.g. "e2e4"), in the game identified by id.
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameMove.
+func (s *BoardService) MakeMove(ctx context.Context, id, move string, opts *MakeMoveOptions) (*Response, error) {
+	return gameAction(ctx, s.client, "board", id, "move/"+move, opts)
+}
+
+// ChatMessage represents a message of the chat of a Lichess game.
+type ChatMessage struct {
+	User string `json:"user"`
+	Text string `json:"text"`
+}
+
+// GetChat gets the messages posted in the chat of the game identified by id.
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameChatGet.
+func (s *BoardService) GetChat(ctx context.Context, id string) ([]*ChatMessage, *Response, error) {
+	return getGameChat(ctx, s.client, "board", id)
+}
+
+// WriteChat posts a message in the given chat room of the game identified by id.
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameChatPost.
+func (s *BoardService) WriteChat(ctx context.Context, id string, room ChatRoom, text string) (*Response, error) {
+	return writeGameChat(ctx, s.client, "board", id, room, text)
+}
+
+// Abort aborts the game identified by id.
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameAbort.
+func (s *BoardService) Abort(ctx context.Context, id string) (*Response, error) {
+	return gameAction(ctx, s.client, "board", id, "abort", nil)
+}
+
+// Resign resigns the game identified by id.
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameResign.
+func (s *BoardService) Resign(ctx context.Context, id string) (*Response, error) {
+	return gameAction(ctx, s.client, "board", id, "resign", nil)
+}
+
+// HandleDraw offers or accepts a draw, if accept is true, or
+// declines a draw offer otherwise, in the game identified by id.
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameDraw.
+func (s *BoardService) HandleDraw(ctx context.Context, id string, accept bool) (*Response, error) {
+	return gameAction(ctx, s.client, "board", id, "draw/"+yesNo(accept), nil)
+}
+
+// HandleTakeback proposes or accepts a takeback, if accept is true, or
+// declines a takeback proposal otherwise, in the game identified by id.
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameTakeback.
+func (s *BoardService) HandleTakeback(ctx context.Context, id string, accept bool) (*Response, error) {
+	return gameAction(ctx, s.client, "board", id, "takeback/"+yesNo(accept), nil)
+}
+
+// ClaimVictory claims victory in the game identified by id,
+// once the opponent has left it for long enough (see [OpponentGone]).
+// Find more details at https://lichess.org/api#tag/Board/operation/boardGameClaimVictory.
+func (s *BoardService) ClaimVictory(ctx context.Context, id string) (*Response, error) {
+	return gameAction(ctx, s.client, "board", id, "claim-victory", nil)
+}
+
+// gameAction sends a POST request to the given action (e.g. "resign")
+// of the game identified by id, either of the Board API or of the Bot API.
+func gameAction(ctx context.Context, c *Client, api, id, action string, opts interface{}) (*Response, error) {
+	u, err := addOptions(fmt.Sprintf("api/%v/game/%v/%v", api, id, action), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(ctx, http.MethodPost, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return c.Do(req, io.Discard)
+}
+
+func getGameChat(ctx context.Context, c *Client, api, id string) ([]*ChatMessage, *Response, error) {
+	u := fmt.Sprintf("api/%v/game/%v/chat", api, id)
+
+	req, err := c.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var messages []*ChatMessage
+	resp, err := c.Do(req, &messages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return messages, resp, nil
+}
+
+// writeChatOptions holds the room and the text of a chat message.
+type writeChatOptions struct {
+	Room ChatRoom `url:"room"`
+	Text string   `url:"text"`
+}
+
+func writeGameChat(ctx context.Context, c *Client, api, id string, room ChatRoom, text string) (*Response, error) {
+	u := fmt.Sprintf("api/%v/game/%v/chat", api, id)
+
+	body, err := formBody(writeChatOptions{Room: room, Text: text})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return c.Do(req, io.Discard)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+
+	return "no"
+}
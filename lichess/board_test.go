@@ -0,0 +1,135 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestBoardService_actions(t *testing.T) {
+	t.Parallel()
+
+	offeringDraw := true
+
+	tcs := map[string]struct {
+		path      string
+		wantQuery url.Values
+		call      func(s *lichess.BoardService) (*lichess.Response, error)
+	}{
+		"make move": {
+			path: "POST /api/board/game/abcdefgh/move/e2e4",
+			call: func(s *lichess.BoardService) (*lichess.Response, error) {
+				return s.MakeMove(context.Background(), "abcdefgh", "e2e4", nil)
+			},
+		},
+		"make move offering draw": {
+			path:      "POST /api/board/game/abcdefgh/move/e7e8q",
+			wantQuery: url.Values{"offeringDraw": {"true"}},
+			call: func(s *lichess.BoardService) (*lichess.Response, error) {
+				return s.MakeMove(context.Background(), "abcdefgh", "e7e8q",
+					&lichess.MakeMoveOptions{OfferingDraw: &offeringDraw})
+			},
+		},
+		"abort": {
+			path: "POST /api/board/game/abcdefgh/abort",
+			call: func(s *lichess.BoardService) (*lichess.Response, error) {
+				return s.Abort(context.Background(), "abcdefgh")
+			},
+		},
+		"resign": {
+			path: "POST /api/board/game/abcdefgh/resign",
+			call: func(s *lichess.BoardService) (*lichess.Response, error) {
+				return s.Resign(context.Background(), "abcdefgh")
+			},
+		},
+		"accept draw": {
+			path: "POST /api/board/game/abcdefgh/draw/yes",
+			call: func(s *lichess.BoardService) (*lichess.Response, error) {
+				return s.HandleDraw(context.Background(), "abcdefgh", true)
+			},
+		},
+		"decline takeback": {
+			path: "POST /api/board/game/abcdefgh/takeback/no",
+			call: func(s *lichess.BoardService) (*lichess.Response, error) {
+				return s.HandleTakeback(context.Background(), "abcdefgh", false)
+			},
+		},
+		"claim victory": {
+			path: "POST /api/board/game/abcdefgh/claim-victory",
+			call: func(s *lichess.BoardService) (*lichess.Response, error) {
+				return s.ClaimVictory(context.Background(), "abcdefgh")
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc(tc.path, func(w http.ResponseWriter, r *http.Request) {
+				if tc.wantQuery != nil {
+					assert.Equal(t, tc.wantQuery, r.URL.Query())
+				}
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			})
+
+			_, err := tc.call(client.Board)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBoardService_MakeMove_errorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/board/game/abcdefgh/move/e2e5", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"Not your turn, or game already over"}`))
+	})
+
+	_, err := client.Board.MakeMove(context.Background(), "abcdefgh", "e2e5", nil)
+	require.ErrorIs(t, err, lichess.ErrBadRequest)
+
+	var errResp *lichess.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, "Not your turn, or game already over", errResp.Message)
+}
+
+func TestBoardService_GetChat(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/board/game/abcdefgh/chat", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"user":"alice","text":"hi"},{"user":"lichess","text":"Takeback sent"}]`))
+	})
+
+	messages, _, err := client.Board.GetChat(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+	assert.Equal(t, []*lichess.ChatMessage{
+		{User: "alice", Text: "hi"},
+		{User: "lichess", Text: "Takeback sent"},
+	}, messages)
+}
+
+func TestBoardService_WriteChat(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/board/game/abcdefgh/chat", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{"room": {"spectator"}, "text": {"good game & thanks"}}, r.PostForm)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	_, err := client.Board.WriteChat(context.Background(), "abcdefgh", lichess.SpectatorChatRoom, "good game & thanks")
+	require.NoError(t, err)
+}
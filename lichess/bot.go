@@ -0,0 +1,100 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// BotService handles communication with the Bot API, used to play games
+// with an engine, from a Lichess bot account. All of its methods require
+// authentication, with the bot:play scope. See the bot package for a
+// framework to write bots on top of it.
+//
+// Lichess API docs: https://lichess.org/api#tag/Bot
+type BotService service
+
+// UpgradeAccount upgrades the authenticated user to a bot account.
+// It is irreversible, and only works for accounts that haven't played any game yet.
+// Find more details at https://lichess.org/api#tag/Bot/operation/botAccountUpgrade.
+func (s *BotService) UpgradeAccount(ctx context.Context) (*Response, error) {
+	u := "api/bot/account/upgrade"
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
+
+// StreamEvents streams the [IncomingEvent] of the authenticated bot,
+// like games starting or finishing and incoming challenges.
+// Equivalent to [BoardService.StreamEvents].
+// Find more details at https://lichess.org/api#tag/Bot/operation/apiStreamEvent.
+func (s *BotService) StreamEvents(ctx context.Context) (chan IncomingEvent, *Response, error) {
+	return streamIncomingEvents(ctx, s.client)
+}
+
+// Events returns an iterator over the [IncomingEvent] of the authenticated bot.
+// Equivalent to [BotService.StreamEvents] but with range-over-func semantics.
+func (s *BotService) Events(ctx context.Context) iter.Seq2[IncomingEvent, error] {
+	return incomingEventsSeq(ctx, s.client)
+}
+
+// StreamGame streams the [GameStateEvent] happening at the game identified by id,
+// which must be played by the authenticated bot. The first event is a [GameFull].
+// Equivalent to [BoardService.StreamGame].
+// Find more details at https://lichess.org/api#tag/Bot/operation/botGameStream.
+func (s *BotService) StreamGame(ctx context.Context, id string) (chan GameStateEvent, *Response, error) {
+	return streamGameState(ctx, s.client, func() (*http.Request, error) {
+		return s.gameRequest(ctx, id)
+	})
+}
+
+// GameEvents returns an iterator over the [GameStateEvent] happening at the game identified by id.
+// Equivalent to [BotService.StreamGame] but with range-over-func semantics.
+func (s *BotService) GameEvents(ctx context.Context, id string) iter.Seq2[GameStateEvent, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.gameRequest(ctx, id)
+	}, parseGameStateEvent)
+}
+
+func (s *BotService) gameRequest(ctx context.Context, id string) (*http.Request, error) {
+	u := fmt.Sprintf("api/bot/game/stream/%v", id)
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
+
+// MakeMove plays a move, in UCI format (e.g. "e2e4"), in the game identified by id.
+// Find more details at https://lichess.org/api#tag/Bot/operation/botGameMove.
+func (s *BotService) MakeMove(ctx context.Context, id, move string, opts *MakeMoveOptions) (*Response, error) {
+	return gameAction(ctx, s.client, "bot", id, "move/"+move, opts)
+}
+
+// GetChat gets the messages posted in the chat of the game identified by id.
+// Find more details at https://lichess.org/api#tag/Bot/operation/botGameChatGet.
+func (s *BotService) GetChat(ctx context.Context, id string) ([]*ChatMessage, *Response, error) {
+	return getGameChat(ctx, s.client, "bot", id)
+}
+
+// WriteChat posts a message in the given chat room of the game identified by id.
+// Find more details at https://lichess.org/api#tag/Bot/operation/botGameChat.
+func (s *BotService) WriteChat(ctx context.Context, id string, room ChatRoom, text string) (*Response, error) {
+	return writeGameChat(ctx, s.client, "bot", id, room, text)
+}
+
+// Abort aborts the game identified by id.
+// Find more details at https://lichess.org/api#tag/Bot/operation/botGameAbort.
+func (s *BotService) Abort(ctx context.Context, id string) (*Response, error) {
+	return gameAction(ctx, s.client, "bot", id, "abort", nil)
+}
+
+// Resign resigns the game identified by id.
+// Find more details at https://lichess.org/api#tag/Bot/operation/botGameResign.
+func (s *BotService) Resign(ctx context.Context, id string) (*Response, error) {
+	return gameAction(ctx, s.client, "bot", id, "resign", nil)
+}
@@ -0,0 +1,286 @@
+// Package bot provides a framework to write Lichess bots on top of
+// [lichess.Client]: it runs the loop of incoming events of the bot account,
+// dispatches challenges and moves to an [Engine], and plays every game
+// in its own goroutine.
+//
+// Lichess API docs: https://lichess.org/api#tag/Bot
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+// Engine decides how a [Bot] plays. Its methods may be called
+// concurrently, from the goroutines of different games.
+type Engine interface {
+	// HandleChallenge reports whether the bot accepts the given incoming
+	// challenge, or otherwise, the reason to decline it.
+	HandleChallenge(ctx context.Context, challenge *lichess.Challenge) (accept bool, reason lichess.DeclineReason)
+
+	// Move returns the move to play, in UCI format (e.g. "e2e4"), in the given
+	// game and state. It's called whenever it's the turn of the bot. If it
+	// returns an error, the bot stops playing the game (but doesn't resign).
+	Move(ctx context.Context, game *lichess.GameFull, state *lichess.GameState) (string, error)
+}
+
+// ErrBotRunning is returned by [Bot.Run] if the bot is already running.
+var ErrBotRunning = errors.New("bot: already running")
+
+// errEventStreamEnded is returned by [Bot.Run] if Lichess
+// ends the event stream, which is expected to last forever.
+var errEventStreamEnded = errors.New("bot: event stream ended")
+
+// Bot runs a Lichess bot, with the given [Engine].
+type Bot struct {
+	client *lichess.Client
+	engine Engine
+
+	mu          sync.Mutex
+	running     bool
+	games       map[string]bool // Games being played, by id.
+	wg          sync.WaitGroup  // Goroutines of the games being played.
+	stopEvents  context.CancelFunc
+	cancelGames context.CancelFunc
+	done        chan struct{} // Closed when Run returns.
+}
+
+// New returns a new [Bot] that plays with the given engine. The client
+// must be authenticated with the token of a bot account, with the bot:play
+// scope. Logs, if any, are written to the [lichess.Client.Logger].
+func New(client *lichess.Client, engine Engine) *Bot {
+	return &Bot{client: client, engine: engine}
+}
+
+// Run runs the loop of incoming events of the bot, accepting or declining
+// challenges, and playing every game that starts, until ctx is done, the
+// bot is shut down with [Bot.Shutdown], or the event stream breaks.
+// Run returns once all the games being played have ended. It returns nil
+// after a shutdown, ctx.Err() when ctx is done, and the error that broke
+// the event stream otherwise.
+func (b *Bot) Run(ctx context.Context) error {
+	gamesCtx, cancelGames := context.WithCancel(ctx)
+	defer cancelGames()
+
+	eventsCtx, stopEvents := context.WithCancel(gamesCtx)
+	defer stopEvents()
+
+	b.mu.Lock()
+	if b.running {
+		b.mu.Unlock()
+		return ErrBotRunning
+	}
+	b.running = true
+	b.games = make(map[string]bool)
+	b.stopEvents = stopEvents
+	b.cancelGames = cancelGames
+	b.done = make(chan struct{})
+	done := b.done
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		b.running = false
+		b.mu.Unlock()
+
+		close(done)
+	}()
+
+	err := b.runEvents(eventsCtx, gamesCtx)
+
+	// If the event stream broke, stop the games too. Otherwise (shutdown),
+	// the games go on, until they end, or the shutdown is forced.
+	if err != nil || ctx.Err() != nil {
+		cancelGames()
+	}
+
+	b.wg.Wait()
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return err
+}
+
+// runEvents handles the incoming events of the bot, until the event
+// stream ends. Games are played with gamesCtx, so they outlive eventsCtx.
+func (b *Bot) runEvents(eventsCtx, gamesCtx context.Context) error {
+	account, _, err := b.client.Account.GetAccount(eventsCtx)
+	if err != nil {
+		return ignoreCanceled(eventsCtx, err)
+	}
+
+	events, _, err := b.client.Bot.StreamEvents(eventsCtx)
+	if err != nil {
+		return ignoreCanceled(eventsCtx, err)
+	}
+
+	for event := range events {
+		switch e := event.(type) {
+		case lichess.ChallengeEvent:
+			b.handleChallenge(eventsCtx, account.Id, e.Challenge)
+		case lichess.GameStartEvent:
+			if e.Game != nil && (e.Game.Compat == nil || e.Game.Compat.Bot) {
+				b.startGame(gamesCtx, account.Id, e.Game.GameId)
+			}
+		case lichess.IncomingEventError:
+			return e
+		}
+	}
+
+	if eventsCtx.Err() == nil {
+		return errEventStreamEnded
+	}
+
+	return nil
+}
+
+// ignoreCanceled returns nil if the error is caused by ctx being done.
+func ignoreCanceled(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
+
+// Shutdown gracefully shuts down the bot: it stops handling incoming events,
+// so no more challenges are accepted, and waits for the games being played
+// to end. If ctx is done before, the games are stopped (but not resigned),
+// and ctx.Err() is returned, once Run returns.
+func (b *Bot) Shutdown(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.running {
+		b.mu.Unlock()
+		return nil
+	}
+	stopEvents, cancelGames, done := b.stopEvents, b.cancelGames, b.done
+	b.mu.Unlock()
+
+	stopEvents()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		cancelGames()
+		<-done
+
+		return ctx.Err()
+	}
+}
+
+// handleChallenge asks the engine whether to accept an incoming
+// challenge, and accepts or declines it accordingly.
+func (b *Bot) handleChallenge(ctx context.Context, me string, challenge *lichess.Challenge) {
+	if challenge == nil || (challenge.Challenger != nil && challenge.Challenger.Id == me) {
+		return // Outgoing challenge.
+	}
+
+	var err error
+	if accept, reason := b.engine.HandleChallenge(ctx, challenge); accept {
+		_, err = b.client.Challenges.AcceptChallenge(ctx, challenge.Id)
+	} else {
+		_, err = b.client.Challenges.DeclineChallenge(ctx, challenge.Id, reason)
+	}
+
+	if err != nil {
+		b.logError(ctx, "handling challenge", challenge.Id, err)
+	}
+}
+
+// startGame starts playing the game identified by id, in its own
+// goroutine, unless it's already being played.
+func (b *Bot) startGame(ctx context.Context, me, id string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.games[id] {
+		return
+	}
+	b.games[id] = true
+	b.wg.Add(1)
+
+	go func() {
+		defer b.wg.Done()
+		defer func() {
+			b.mu.Lock()
+			delete(b.games, id)
+			b.mu.Unlock()
+		}()
+
+		if err := b.play(ctx, me, id); err != nil {
+			b.logError(ctx, "playing game", id, err)
+		}
+	}()
+}
+
+// play plays the game identified by id, until it ends, or ctx is done.
+func (b *Bot) play(ctx context.Context, me, id string) error {
+	// Close the game stream whenever play returns (e.g. on an engine
+	// error), not only when ctx is done, so the connection doesn't leak.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, _, err := b.client.Bot.StreamGame(ctx, id)
+	if err != nil {
+		return ignoreCanceled(ctx, err)
+	}
+
+	var game *lichess.GameFull
+
+	for event := range events {
+		var state lichess.GameState
+
+		switch e := event.(type) {
+		case lichess.GameFull:
+			game, state = &e, e.State
+		case lichess.GameState:
+			state = e
+		case lichess.GameStateEventError:
+			return ignoreCanceled(ctx, e)
+		default:
+			continue
+		}
+
+		if game == nil || state.Finished() || !myTurn(game, &state, me) {
+			continue
+		}
+
+		move, err := b.engine.Move(ctx, game, &state)
+		if err != nil {
+			return fmt.Errorf("engine: %w", err)
+		}
+
+		if _, err := b.client.Bot.MakeMove(ctx, id, move, nil); err != nil {
+			b.logError(ctx, "making move "+move, id, err)
+		}
+	}
+
+	return nil
+}
+
+// myTurn reports whether it's the turn of the user identified by me,
+// given the number of moves played and the side to move at the beginning.
+func myTurn(game *lichess.GameFull, state *lichess.GameState, me string) bool {
+	white := strings.EqualFold(game.White.Id, me)
+
+	whiteToMove := len(strings.Fields(state.Moves))%2 == 0
+	if fields := strings.Fields(game.InitialFen); len(fields) > 1 && fields[1] == "b" {
+		whiteToMove = !whiteToMove
+	}
+
+	return white == whiteToMove
+}
+
+func (b *Bot) logError(ctx context.Context, msg, id string, err error) {
+	if b.client.Logger != nil {
+		b.client.Logger.ErrorContext(ctx, "bot: "+msg, "id", id, "error", err)
+	}
+}
@@ -0,0 +1,234 @@
+package bot_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+	"github.com/joanlopez/go-lichess/lichess/bot"
+)
+
+// setup returns a client authenticated as the "me" bot account, which sends
+// its requests to a test server, along with the mux used to register the handlers
+// of the test. The stream of incoming events sends the given events, and then
+// blocks until the bot stops handling them.
+func setup(t *testing.T, events string) (*lichess.Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /api/account", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"me","username":"Me"}`))
+	})
+
+	mux.HandleFunc("GET /api/stream/event", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(events))
+		// Explicit ignore error.
+		_ = http.NewResponseController(w).Flush()
+
+		<-r.Context().Done()
+	})
+
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+
+	client := lichess.NewClient(nil).WithAuthToken("lip_bot")
+	client.BaseURL = baseURL
+
+	return client, mux
+}
+
+// writeEvent writes a line of a stream, and flushes it.
+func writeEvent(w http.ResponseWriter, event string) {
+	_, _ = w.Write([]byte(event + "\n"))
+	// Explicit ignore error.
+	_ = http.NewResponseController(w).Flush()
+}
+
+// engine is a [bot.Engine] that accepts the challenges whose id starts with
+// "ok", and plays the moves returned by move, if not nil, or fails otherwise.
+type engine struct {
+	move func(state *lichess.GameState) string
+}
+
+func (e engine) HandleChallenge(_ context.Context, challenge *lichess.Challenge) (bool, lichess.DeclineReason) {
+	return challenge.Id[:2] == "ok", lichess.DeclineLater
+}
+
+func (e engine) Move(_ context.Context, _ *lichess.GameFull, state *lichess.GameState) (string, error) {
+	if e.move == nil {
+		return "", errors.New("engine crashed")
+	}
+
+	return e.move(state), nil
+}
+
+func TestBot(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t, `{"type":"challenge","challenge":{"id":"ok1","challenger":{"id":"alice"}}}
+{"type":"challenge","challenge":{"id":"no1","challenger":{"id":"bob"}}}
+{"type":"challenge","challenge":{"id":"no2","challenger":{"id":"me"}}}
+{"type":"gameStart","game":{"gameId":"abcdefgh","compat":{"bot":true}}}
+`)
+
+	var (
+		mu       sync.Mutex
+		accepted []string
+		declined = make(map[string]string)
+		moves    = make(chan string, 2)
+	)
+
+	mux.HandleFunc("POST /api/challenge/{id}/accept", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		accepted = append(accepted, r.PathValue("id"))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	mux.HandleFunc("POST /api/challenge/{id}/decline", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		declined[r.PathValue("id")] = r.FormValue("reason")
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	mux.HandleFunc("POST /api/bot/game/abcdefgh/move/{move}", func(w http.ResponseWriter, r *http.Request) {
+		moves <- r.PathValue("move")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	mux.HandleFunc("GET /api/bot/game/stream/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		writeEvent(w, `{"type":"gameFull","id":"abcdefgh","white":{"id":"me"},"black":{"id":"alice"},`+
+			`"initialFen":"startpos","state":{"type":"gameState","moves":"","status":"started"}}`)
+		assert.Equal(t, "e2e4", <-moves)
+
+		// The bot doesn't play on the turn of the opponent.
+		writeEvent(w, `{"type":"gameState","moves":"e2e4","status":"started"}`)
+		writeEvent(w, `{"type":"gameState","moves":"e2e4 e7e5","status":"started"}`)
+		assert.Equal(t, "g1f3", <-moves)
+
+		writeEvent(w, `{"type":"gameState","moves":"e2e4 e7e5 g1f3","status":"resign","winner":"white"}`)
+	})
+
+	var played []string
+
+	b := bot.New(client, engine{move: func(state *lichess.GameState) string {
+		played = append(played, state.Moves)
+		if state.Moves == "" {
+			return "e2e4"
+		}
+		return "g1f3"
+	}})
+
+	errs := make(chan error, 1)
+	go func() { errs <- b.Run(context.Background()) }()
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(accepted) == 1 && len(declined) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Shutdown waits for the game being played to end.
+	require.NoError(t, b.Shutdown(context.Background()))
+	require.NoError(t, <-errs)
+
+	assert.Equal(t, []string{"ok1"}, accepted)
+	// Outgoing challenges are ignored.
+	assert.Equal(t, map[string]string{"no1": "later"}, declined)
+	assert.Equal(t, []string{"", "e2e4 e7e5"}, played)
+}
+
+func TestBot_engineError(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t, `{"type":"gameStart","game":{"gameId":"abcdefgh","compat":{"bot":true}}}`+"\n")
+
+	gameClosed := make(chan struct{})
+	mux.HandleFunc("GET /api/bot/game/stream/abcdefgh", func(w http.ResponseWriter, r *http.Request) {
+		writeEvent(w, `{"type":"gameFull","id":"abcdefgh","white":{"id":"me"},"black":{"id":"alice"},`+
+			`"initialFen":"startpos","state":{"type":"gameState","moves":"","status":"started"}}`)
+
+		// The game goes on, unless the bot closes the connection.
+		<-r.Context().Done()
+		close(gameClosed)
+	})
+
+	b := bot.New(client, engine{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := make(chan error, 1)
+	go func() { errs <- b.Run(ctx) }()
+
+	select {
+	case <-gameClosed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the game stream wasn't closed after the engine error")
+	}
+
+	// The bot keeps running, to play other games.
+	select {
+	case err := <-errs:
+		t.Fatalf("the bot stopped: %v", err)
+	default:
+	}
+
+	require.NoError(t, b.Shutdown(context.Background()))
+	require.NoError(t, <-errs)
+}
+
+func TestBot_Run(t *testing.T) {
+	t.Parallel()
+
+	client, _ := setup(t, "")
+
+	b := bot.New(client, engine{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errs := make(chan error, 1)
+	go func() { errs <- b.Run(ctx) }()
+
+	require.Eventually(t, func() bool {
+		return errors.Is(b.Run(ctx), bot.ErrBotRunning)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestBot_Run_eventStreamError(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /api/account", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"me"}`))
+	})
+	mux.HandleFunc("GET /api/stream/event", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	client, err := lichess.NewClientWithOptions(nil, lichess.WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	err = bot.New(client, engine{}).Run(context.Background())
+	require.ErrorIs(t, err, lichess.ErrUnauthorized)
+}
@@ -0,0 +1,421 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+)
+
+// BroadcastsService handles communication with the broadcast related
+// methods of the Lichess API, used to relay over-the-board events.
+//
+// Lichess API docs: https://lichess.org/api#tag/Broadcasts
+type BroadcastsService service
+
+// Broadcast represents a Lichess broadcast tournament, with its rounds.
+type Broadcast struct {
+	Tour           *BroadcastTour    `json:"tour,omitempty"`
+	Group          *string           `json:"group,omitempty"` // Name of the group of broadcasts, if any.
+	Rounds         []*BroadcastRound `json:"rounds,omitempty"`
+	DefaultRoundId *string           `json:"defaultRoundId,omitempty"`
+}
+
+// BroadcastTour represents a Lichess broadcast tournament.
+type BroadcastTour struct {
+	Id          string             `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
+	Slug        string             `json:"slug,omitempty"`
+	Info        *BroadcastTourInfo `json:"info,omitempty"`
+	CreatedAt   *Timestamp         `json:"createdAt,omitempty"`
+	Url         string             `json:"url,omitempty"`
+	Tier        *int               `json:"tier,omitempty"`
+	Dates       []*Timestamp       `json:"dates,omitempty"` // First and last dates of the rounds.
+	Image       *string            `json:"image,omitempty"`
+	Description *string            `json:"description,omitempty"` // Full description, in HTML.
+	TeamTable   bool               `json:"teamTable,omitempty"`
+}
+
+// BroadcastTourInfo represents additional information
+// about a Lichess broadcast tournament.
+type BroadcastTourInfo struct {
+	TimeControl     *string `json:"tc,omitempty"`
+	FideTimeControl *string `json:"fideTc,omitempty"`
+	TimeZone        *string `json:"timeZone,omitempty"`
+	Location        *string `json:"location,omitempty"`
+	Format          *string `json:"format,omitempty"`
+	Players         *string `json:"players,omitempty"`
+	Website         *string `json:"website,omitempty"`
+	Standings       *string `json:"standings,omitempty"`
+}
+
+// BroadcastRound represents a round of a Lichess broadcast tournament.
+type BroadcastRound struct {
+	Id                  string     `json:"id,omitempty"`
+	Name                string     `json:"name,omitempty"`
+	Slug                string     `json:"slug,omitempty"`
+	Url                 string     `json:"url,omitempty"`
+	CreatedAt           *Timestamp `json:"createdAt,omitempty"`
+	Rated               bool       `json:"rated,omitempty"`
+	Ongoing             bool       `json:"ongoing,omitempty"`
+	StartsAt            *Timestamp `json:"startsAt,omitempty"`
+	StartsAfterPrevious bool       `json:"startsAfterPrevious,omitempty"`
+	FinishedAt          *Timestamp `json:"finishedAt,omitempty"`
+	Finished            bool       `json:"finished,omitempty"`
+	Delay               *int       `json:"delay,omitempty"` // In seconds.
+}
+
+// BroadcastRoundWithTour represents a round of a Lichess
+// broadcast tournament, along with the tournament itself.
+type BroadcastRoundWithTour struct {
+	Round *BroadcastRound `json:"round,omitempty"`
+	Tour  *BroadcastTour  `json:"tour,omitempty"`
+	Study *struct {
+		Writeable bool `json:"writeable,omitempty"` // Whether the authenticated user can push games.
+	} `json:"study,omitempty"`
+}
+
+// GetOfficialBroadcastsOptions specifies parameters for BroadcastsService.GetOfficialBroadcasts method.
+type GetOfficialBroadcastsOptions struct {
+	Nb   *int  `url:"nb,omitempty"`
+	Html *bool `url:"html,omitempty"` // Converts the description from Markdown to HTML.
+}
+
+// GetOfficialBroadcasts gets the official broadcasts, ongoing first, then upcoming and finished ones.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastsOfficial.
+func (s *BroadcastsService) GetOfficialBroadcasts(
+	ctx context.Context,
+	opts *GetOfficialBroadcastsOptions,
+) ([]*Broadcast, *Response, error) {
+	req, err := s.officialBroadcastsRequest(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var broadcasts []*Broadcast
+	resp, err := s.client.Do(req, &broadcasts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return broadcasts, resp, nil
+}
+
+// OfficialBroadcasts returns an iterator over the official broadcasts.
+// Equivalent to [BroadcastsService.GetOfficialBroadcasts] but with range-over-func semantics.
+func (s *BroadcastsService) OfficialBroadcasts(
+	ctx context.Context,
+	opts *GetOfficialBroadcastsOptions,
+) iter.Seq2[*Broadcast, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.officialBroadcastsRequest(ctx, opts)
+	}, decodeJson[*Broadcast])
+}
+
+func (s *BroadcastsService) officialBroadcastsRequest(
+	ctx context.Context,
+	opts *GetOfficialBroadcastsOptions,
+) (*http.Request, error) {
+	u, err := addOptions("api/broadcast", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
+
+// GetBroadcast gets the broadcast tournament identified by id, with its rounds.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastTourGet.
+func (s *BroadcastsService) GetBroadcast(ctx context.Context, id string) (*Broadcast, *Response, error) {
+	u := fmt.Sprintf("api/broadcast/%v", id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var broadcast *Broadcast
+	resp, err := s.client.Do(req, &broadcast)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return broadcast, resp, nil
+}
+
+// BroadcastOptions specifies parameters for BroadcastsService.CreateBroadcast
+// and BroadcastsService.UpdateBroadcast methods. Name is required.
+type BroadcastOptions struct {
+	Name            *string `url:"name,omitempty"`
+	Description     *string `url:"description,omitempty"` // Short description.
+	Markdown        *string `url:"markdown,omitempty"`    // Full description, in Markdown.
+	ShowScores      *bool   `url:"showScores,omitempty"`
+	ShowRatingDiffs *bool   `url:"showRatingDiffs,omitempty"`
+	TeamTable       *bool   `url:"teamTable,omitempty"`
+	// Visibility is either "public", "unlisted" or "private".
+	Visibility *string `url:"visibility,omitempty"`
+	// Players replaces the names, ratings and titles of the players, one per line.
+	Players *string `url:"players,omitempty"`
+	// Teams assigns the players to teams, one player per line.
+	Teams *string `url:"teams,omitempty"`
+	// Tier is reserved to Lichess admins.
+	Tier *int `url:"tier,omitempty"`
+
+	// Additional information.
+	TimeControl     *string `url:"info.tc,omitempty"`
+	FideTimeControl *string `url:"info.fideTc,omitempty"`
+	TimeZone        *string `url:"info.timeZone,omitempty"`
+	Location        *string `url:"info.location,omitempty"`
+	Format          *string `url:"info.format,omitempty"`
+	InfoPlayers     *string `url:"info.players,omitempty"` // Notable players.
+	Website         *string `url:"info.website,omitempty"`
+	Standings       *string `url:"info.standings,omitempty"`
+}
+
+// CreateBroadcast creates a new broadcast tournament, owned by the authenticated user.
+// Its rounds are created with [BroadcastsService.CreateRound].
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastTourCreate.
+func (s *BroadcastsService) CreateBroadcast(
+	ctx context.Context,
+	opts *BroadcastOptions,
+) (*Broadcast, *Response, error) {
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, "broadcast/new", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var broadcast *Broadcast
+	resp, err := s.client.Do(req, &broadcast)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return broadcast, resp, nil
+}
+
+// UpdateBroadcast updates the broadcast tournament identified by id. All the
+// options not set are reset to their default values, so Name is required.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastTourUpdate.
+func (s *BroadcastsService) UpdateBroadcast(ctx context.Context, id string, opts *BroadcastOptions) (*Response, error) {
+	u := fmt.Sprintf("broadcast/%v/edit", id)
+
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
+
+// BroadcastRoundOptions specifies parameters for BroadcastsService.CreateRound
+// and BroadcastsService.UpdateRound methods. Name is required. Games are either
+// synced from one of the sources (SyncUrl, SyncUrls, SyncIds or SyncUsers),
+// or pushed with [BroadcastsService.PushPGN], if none is set.
+type BroadcastRoundOptions struct {
+	Name *string `url:"name,omitempty"`
+	// SyncUrl is the URL that Lichess polls to get the PGN of the games.
+	SyncUrl *string `url:"syncUrl,omitempty"`
+	// SyncUrls are URLs that Lichess polls to get the PGN of the games, one per line.
+	SyncUrls *string `url:"syncUrls,omitempty"`
+	// SyncIds are the identifiers of the Lichess games to sync, separated by spaces.
+	SyncIds *string `url:"syncIds,omitempty"`
+	// SyncUsers are the Lichess usernames whose ongoing games are synced, separated by spaces.
+	SyncUsers *string `url:"syncUsers,omitempty"`
+	// OnlyRound filters the games of the source by their Round PGN tag.
+	OnlyRound *int `url:"onlyRound,omitempty"`
+	// Slices filters the games of the source by their position, e.g. "1-5,8".
+	Slices              *string `url:"slices,omitempty"`
+	StartsAt            *int64  `url:"startsAt,omitempty"` // In milliseconds since epoch.
+	StartsAfterPrevious *bool   `url:"startsAfterPrevious,omitempty"`
+	Delay               *int    `url:"delay,omitempty"`  // In seconds.
+	Period              *int    `url:"period,omitempty"` // Polling period of the sources, in seconds.
+	Finished            *bool   `url:"finished,omitempty"`
+	Rated               *bool   `url:"rated,omitempty"`
+}
+
+// CreateRound creates a new round of the broadcast tournament identified by tourId.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastRoundCreate.
+func (s *BroadcastsService) CreateRound(
+	ctx context.Context,
+	tourId string,
+	opts *BroadcastRoundOptions,
+) (*BroadcastRoundWithTour, *Response, error) {
+	return s.saveRound(ctx, fmt.Sprintf("broadcast/%v/new", tourId), opts)
+}
+
+// UpdateRound updates the broadcast round identified by id. All the
+// options not set are reset to their default values, so Name is required.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastRoundUpdate.
+func (s *BroadcastsService) UpdateRound(
+	ctx context.Context,
+	id string,
+	opts *BroadcastRoundOptions,
+) (*BroadcastRoundWithTour, *Response, error) {
+	return s.saveRound(ctx, fmt.Sprintf("broadcast/round/%v/edit", id), opts)
+}
+
+func (s *BroadcastsService) saveRound(
+	ctx context.Context,
+	u string,
+	opts *BroadcastRoundOptions,
+) (*BroadcastRoundWithTour, *Response, error) {
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var round *BroadcastRoundWithTour
+	resp, err := s.client.Do(req, &round)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return round, resp, nil
+}
+
+// BroadcastPushResult represents the result of pushing PGN to a broadcast round.
+type BroadcastPushResult struct {
+	Games []*BroadcastPushGame `json:"games,omitempty"`
+}
+
+// BroadcastPushGame represents the result of pushing a single game to a broadcast round.
+// Error is set if the game couldn't be parsed, in which case Moves is zero.
+type BroadcastPushGame struct {
+	Tags  map[string]string `json:"tags,omitempty"`
+	Moves int               `json:"moves,omitempty"`
+	Error *string           `json:"error,omitempty"`
+}
+
+// PushPGN pushes the PGN of one or more games to the broadcast round identified by id,
+// whose games aren't synced from any source. Games are matched by their PGN tags.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastPush.
+func (s *BroadcastsService) PushPGN(ctx context.Context, id, pgn string) (*BroadcastPushResult, *Response, error) {
+	u := fmt.Sprintf("api/broadcast/round/%v/push", id)
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, RequestBody{
+		Bytes: strings.NewReader(pgn),
+		Type:  "text/plain",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result *BroadcastPushResult
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// StreamRoundPGN streams the PGN of the games of the broadcast round identified
+// by id, as they're updated. The PGN of every game in the round is sent first,
+// and then again every time a move is played. The stream ends when the round
+// finishes, and it's up to the caller to close it.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastStreamRoundPgn.
+func (s *BroadcastsService) StreamRoundPGN(ctx context.Context, id string) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("api/stream/broadcast/round/%v.pgn", id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", "application/x-chess-pgn")
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resp.Body, resp, nil
+}
+
+// ExportRoundPGN writes the PGN of the games of the broadcast round identified by id to w.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastRoundPgn.
+func (s *BroadcastsService) ExportRoundPGN(ctx context.Context, id string, w io.Writer) (*Response, error) {
+	return s.exportPGN(ctx, fmt.Sprintf("api/broadcast/round/%v.pgn", id), w)
+}
+
+// ExportPGN writes the PGN of the games of all the rounds
+// of the broadcast tournament identified by id to w.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastAllRoundsPgn.
+func (s *BroadcastsService) ExportPGN(ctx context.Context, id string, w io.Writer) (*Response, error) {
+	return s.exportPGN(ctx, fmt.Sprintf("api/broadcast/%v.pgn", id), w)
+}
+
+func (s *BroadcastsService) exportPGN(ctx context.Context, u string, w io.Writer) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/x-chess-pgn")
+
+	return s.client.Do(req, w)
+}
+
+// GetMyRoundsOptions specifies parameters for BroadcastsService.GetMyRounds method.
+type GetMyRoundsOptions struct {
+	Nb *int `url:"nb,omitempty"`
+}
+
+// GetMyRounds gets the broadcast rounds the authenticated user can push games to,
+// including the ones of the broadcast tournaments they don't own.
+// Find more details at https://lichess.org/api#tag/Broadcasts/operation/broadcastMyRoundsGet.
+func (s *BroadcastsService) GetMyRounds(
+	ctx context.Context,
+	opts *GetMyRoundsOptions,
+) ([]*BroadcastRoundWithTour, *Response, error) {
+	req, err := s.myRoundsRequest(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rounds []*BroadcastRoundWithTour
+	resp, err := s.client.Do(req, &rounds)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rounds, resp, nil
+}
+
+// MyRounds returns an iterator over the broadcast rounds the authenticated user can push games to.
+// Equivalent to [BroadcastsService.GetMyRounds] but with range-over-func semantics.
+func (s *BroadcastsService) MyRounds(
+	ctx context.Context,
+	opts *GetMyRoundsOptions,
+) iter.Seq2[*BroadcastRoundWithTour, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.myRoundsRequest(ctx, opts)
+	}, decodeJson[*BroadcastRoundWithTour])
+}
+
+func (s *BroadcastsService) myRoundsRequest(ctx context.Context, opts *GetMyRoundsOptions) (*http.Request, error) {
+	u, err := addOptions("api/broadcast/my-rounds", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
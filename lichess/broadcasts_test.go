@@ -0,0 +1,346 @@
+package lichess_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestBroadcastsService_GetOfficialBroadcasts(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/broadcast", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		assert.Equal(t, url.Values{"nb": {"2"}, "html": {"true"}}, r.URL.Query())
+
+		_, _ = w.Write([]byte(`{"tour":{"id":"tour1","name":"World Championship","createdAt":1700000000000,` +
+			`"dates":[1700000000000,1701000000000],"info":{"tc":"120+30","location":"Singapore"},"tier":5},` +
+			`"rounds":[{"id":"round1","name":"Round 1","ongoing":true,"startsAt":1700000000000}],` +
+			`"defaultRoundId":"round1"}
+{"tour":{"id":"tour2","name":"Open"},"group":"Open Festival","rounds":[]}
+`))
+	})
+
+	nb, html := 2, true
+
+	broadcasts, _, err := client.Broadcasts.GetOfficialBroadcasts(context.Background(),
+		&lichess.GetOfficialBroadcastsOptions{Nb: &nb, Html: &html})
+	require.NoError(t, err)
+	require.Len(t, broadcasts, 2)
+
+	tour := broadcasts[0].Tour
+	assert.Equal(t, "World Championship", tour.Name)
+	assert.True(t, time.UnixMilli(1700000000000).Equal(tour.CreatedAt.Time))
+	require.Len(t, tour.Dates, 2)
+	assert.True(t, time.UnixMilli(1701000000000).Equal(tour.Dates[1].Time))
+	assert.Equal(t, "120+30", *tour.Info.TimeControl)
+	assert.Equal(t, "Singapore", *tour.Info.Location)
+	assert.Equal(t, 5, *tour.Tier)
+
+	require.Len(t, broadcasts[0].Rounds, 1)
+	assert.True(t, broadcasts[0].Rounds[0].Ongoing)
+	assert.Equal(t, "round1", *broadcasts[0].DefaultRoundId)
+	assert.Equal(t, "Open Festival", *broadcasts[1].Group)
+}
+
+func TestBroadcastsService_OfficialBroadcasts(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/broadcast", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"tour":{"id":"tour1"}}
+{"tour":{"id":"tour2"}}
+{"tour":{"id":"tour3"}}
+`))
+	})
+
+	var got []string
+	for broadcast, err := range client.Broadcasts.OfficialBroadcasts(context.Background(), nil) {
+		require.NoError(t, err)
+		got = append(got, broadcast.Tour.Id)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"tour1", "tour2"}, got)
+}
+
+func TestBroadcastsService_GetBroadcast(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/broadcast/tour1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+
+		_, _ = w.Write([]byte(`{
+			"tour": {"id": "tour1", "name": "World Championship", "teamTable": true},
+			"rounds": [
+				{"id": "round1", "finished": true, "finishedAt": 1700000000000},
+				{"id": "round2", "startsAfterPrevious": true, "delay": 900}
+			]
+		}`))
+	})
+
+	broadcast, _, err := client.Broadcasts.GetBroadcast(context.Background(), "tour1")
+	require.NoError(t, err)
+	assert.True(t, broadcast.Tour.TeamTable)
+	require.Len(t, broadcast.Rounds, 2)
+	assert.True(t, broadcast.Rounds[0].Finished)
+	assert.True(t, time.UnixMilli(1700000000000).Equal(broadcast.Rounds[0].FinishedAt.Time))
+	assert.True(t, broadcast.Rounds[1].StartsAfterPrevious)
+	assert.Equal(t, 900, *broadcast.Rounds[1].Delay)
+}
+
+func TestBroadcastsService_CreateBroadcast(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /broadcast/new", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"name":          {"Club Championship"},
+			"showScores":    {"true"},
+			"visibility":    {"unlisted"},
+			"info.tc":       {"90+30"},
+			"info.location": {"Barcelona"},
+		}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{"tour":{"id":"tour1","name":"Club Championship"},"rounds":[]}`))
+	})
+
+	name, showScores, visibility, tc, location := "Club Championship", true, "unlisted", "90+30", "Barcelona"
+
+	broadcast, _, err := client.Broadcasts.CreateBroadcast(context.Background(), &lichess.BroadcastOptions{
+		Name:        &name,
+		ShowScores:  &showScores,
+		Visibility:  &visibility,
+		TimeControl: &tc,
+		Location:    &location,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tour1", broadcast.Tour.Id)
+	assert.Empty(t, broadcast.Rounds)
+}
+
+func TestBroadcastsService_UpdateBroadcast(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /broadcast/tour1/edit", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{"name": {"Club Championship 2026"}}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	name := "Club Championship 2026"
+
+	_, err := client.Broadcasts.UpdateBroadcast(context.Background(), "tour1", &lichess.BroadcastOptions{Name: &name})
+	require.NoError(t, err)
+}
+
+func TestBroadcastsService_saveRound(t *testing.T) {
+	t.Parallel()
+
+	name, syncUrl, delay := "Round 1", "https://example.com/round1.pgn", 60
+	opts := &lichess.BroadcastRoundOptions{Name: &name, SyncUrl: &syncUrl, Delay: &delay}
+
+	tcs := map[string]struct {
+		path string
+		call func(client *lichess.Client) (*lichess.BroadcastRoundWithTour, *lichess.Response, error)
+	}{
+		"create": {
+			path: "POST /broadcast/tour1/new",
+			call: func(client *lichess.Client) (*lichess.BroadcastRoundWithTour, *lichess.Response, error) {
+				return client.Broadcasts.CreateRound(context.Background(), "tour1", opts)
+			},
+		},
+		"update": {
+			path: "POST /broadcast/round/round1/edit",
+			call: func(client *lichess.Client) (*lichess.BroadcastRoundWithTour, *lichess.Response, error) {
+				return client.Broadcasts.UpdateRound(context.Background(), "round1", opts)
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc(tc.path, func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				assert.Equal(t, url.Values{
+					"name":    {"Round 1"},
+					"syncUrl": {"https://example.com/round1.pgn"},
+					"delay":   {"60"},
+				}, r.PostForm)
+
+				_, _ = w.Write([]byte(`{"round":{"id":"round1","name":"Round 1","delay":60},` +
+					`"tour":{"id":"tour1"},"study":{"writeable":true}}`))
+			})
+
+			round, _, err := tc.call(client)
+			require.NoError(t, err)
+			assert.Equal(t, "round1", round.Round.Id)
+			assert.Equal(t, "tour1", round.Tour.Id)
+			require.NotNil(t, round.Study)
+			assert.True(t, round.Study.Writeable)
+		})
+	}
+}
+
+func TestBroadcastsService_PushPGN(t *testing.T) {
+	t.Parallel()
+
+	pgn := "[White \"Alice\"]\n[Black \"Bob\"]\n\n1. e4 e5 *"
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/broadcast/round/round1/push", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, pgn, string(body))
+
+		_, _ = w.Write([]byte(`{"games":[
+			{"tags":{"White":"Alice","Black":"Bob"},"moves":2},
+			{"tags":{},"error":"Invalid PGN"}
+		]}`))
+	})
+
+	result, _, err := client.Broadcasts.PushPGN(context.Background(), "round1", pgn)
+	require.NoError(t, err)
+	require.Len(t, result.Games, 2)
+	assert.Equal(t, "Alice", result.Games[0].Tags["White"])
+	assert.Equal(t, 2, result.Games[0].Moves)
+	assert.Nil(t, result.Games[0].Error)
+	assert.Equal(t, "Invalid PGN", *result.Games[1].Error)
+}
+
+func TestBroadcastsService_StreamRoundPGN(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/stream/broadcast/round/round1.pgn", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-chess-pgn", r.Header.Get("Accept"))
+		_, _ = w.Write([]byte("[Event \"Round 1\"]\n\n1. d4 *\n"))
+	})
+
+	body, _, err := client.Broadcasts.StreamRoundPGN(context.Background(), "round1")
+	require.NoError(t, err)
+	defer func() {
+		// Explicit ignore error.
+		_ = body.Close()
+	}()
+
+	pgn, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "[Event \"Round 1\"]\n\n1. d4 *\n", string(pgn))
+}
+
+func TestBroadcastsService_StreamRoundPGN_notFound(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/stream/broadcast/round/round1.pgn", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	body, _, err := client.Broadcasts.StreamRoundPGN(context.Background(), "round1")
+	require.ErrorIs(t, err, lichess.ErrNotFound)
+	assert.Nil(t, body)
+}
+
+func TestBroadcastsService_exportPGN(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		path string
+		call func(client *lichess.Client, w io.Writer) (*lichess.Response, error)
+	}{
+		"round": {
+			path: "GET /api/broadcast/round/round1.pgn",
+			call: func(client *lichess.Client, w io.Writer) (*lichess.Response, error) {
+				return client.Broadcasts.ExportRoundPGN(context.Background(), "round1", w)
+			},
+		},
+		"tournament": {
+			path: "GET /api/broadcast/tour1.pgn",
+			call: func(client *lichess.Client, w io.Writer) (*lichess.Response, error) {
+				return client.Broadcasts.ExportPGN(context.Background(), "tour1", w)
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc(tc.path, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/x-chess-pgn", r.Header.Get("Accept"))
+				_, _ = w.Write([]byte("[Event \"Club Championship\"]\n\n1. e4 *\n"))
+			})
+
+			var pgn bytes.Buffer
+			_, err := tc.call(client, &pgn)
+			require.NoError(t, err)
+			assert.Equal(t, "[Event \"Club Championship\"]\n\n1. e4 *\n", pgn.String())
+		})
+	}
+}
+
+func TestBroadcastsService_GetMyRounds(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/broadcast/my-rounds", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		assert.Equal(t, "1", r.URL.Query().Get("nb"))
+
+		_, _ = w.Write([]byte(`{"round":{"id":"round1"},"tour":{"id":"tour1"},"study":{"writeable":true}}` + "\n"))
+	})
+
+	nb := 1
+	opts := &lichess.GetMyRoundsOptions{Nb: &nb}
+
+	rounds, _, err := client.Broadcasts.GetMyRounds(context.Background(), opts)
+	require.NoError(t, err)
+	require.Len(t, rounds, 1)
+	assert.Equal(t, "round1", rounds[0].Round.Id)
+	assert.True(t, rounds[0].Study.Writeable)
+
+	var got []string
+	for round, err := range client.Broadcasts.MyRounds(context.Background(), opts) {
+		require.NoError(t, err)
+		got = append(got, round.Tour.Id)
+	}
+
+	assert.Equal(t, []string{"tour1"}, got)
+}
+
+func TestBroadcastsService_MyRounds_errorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/broadcast/my-rounds", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	for _, err := range client.Broadcasts.MyRounds(context.Background(), nil) {
+		require.ErrorIs(t, err, lichess.ErrUnauthorized)
+	}
+}
@@ -0,0 +1,391 @@
+package lichess
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ChallengesService handles communication with the challenge related
+// methods of the Lichess API.
+//
+// Lichess API docs: https://lichess.org/api#tag/Challenges
+type ChallengesService service
+
+// Challenge represents a Lichess challenge.
+type Challenge struct {
+	Id               string                `json:"id,omitempty"`
+	Url              string                `json:"url,omitempty"`
+	Status           ChallengeStatus       `json:"status,omitempty"`
+	Challenger       *ChallengeUser        `json:"challenger,omitempty"`
+	DestUser         *ChallengeUser        `json:"destUser,omitempty"` // Nil for open challenges.
+	Variant          *ChallengeVariant     `json:"variant,omitempty"`
+	Rated            bool                  `json:"rated,omitempty"`
+	Speed            GameSpeed             `json:"speed,omitempty"`
+	TimeControl      *ChallengeTimeControl `json:"timeControl,omitempty"`
+	Color            Color                 `json:"color,omitempty"`
+	FinalColor       *Color                `json:"finalColor,omitempty"`
+	Perf             *ChallengePerf        `json:"perf,omitempty"`
+	Direction        *string               `json:"direction,omitempty"` // "in" or "out", for the authenticated user.
+	InitialFen       *string               `json:"initialFen,omitempty"`
+	DeclineReason    *string               `json:"declineReason,omitempty"`
+	DeclineReasonKey *DeclineReason        `json:"declineReasonKey,omitempty"`
+	RematchOf        *string               `json:"rematchOf,omitempty"`
+	Rules            []string              `json:"rules,omitempty"`
+}
+
+// ChallengeStatus represents the status of a Lichess challenge.
+type ChallengeStatus string
+
+const (
+	ChallengeCreated  ChallengeStatus = "created"
+	ChallengeOffline  ChallengeStatus = "offline"
+	ChallengeCanceled ChallengeStatus = "canceled"
+	ChallengeDeclined ChallengeStatus = "declined"
+	ChallengeAccepted ChallengeStatus = "accepted"
+)
+
+// ChallengeUser represents a Lichess user involved in a challenge.
+type ChallengeUser struct {
+	LightUser
+	Rating      *int `json:"rating,omitempty"`
+	Provisional bool `json:"provisional,omitempty"`
+	Online      bool `json:"online,omitempty"`
+	Lag         *int `json:"lag,omitempty"`
+}
+
+// ChallengeVariant represents the variant of a Lichess challenge.
+type ChallengeVariant struct {
+	Key   GameVariant `json:"key,omitempty"`
+	Name  string      `json:"name,omitempty"`
+	Short string      `json:"short,omitempty"`
+}
+
+// UnmarshalJSON decodes the variant, which some endpoints
+// send as a plain key (e.g. "standard"), instead of an object.
+func (v *ChallengeVariant) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		*v = ChallengeVariant{}
+		return json.Unmarshal(data, &v.Key)
+	}
+
+	type challengeVariant ChallengeVariant
+
+	return json.Unmarshal(data, (*challengeVariant)(v))
+}
+
+// ChallengeTimeControl represents the time control of a Lichess challenge.
+// Type is either "clock", "correspondence" or "unlimited".
+type ChallengeTimeControl struct {
+	Type        string  `json:"type,omitempty"`
+	Limit       *int    `json:"limit,omitempty"`     // In seconds.
+	Increment   *int    `json:"increment,omitempty"` // In seconds.
+	Show        *string `json:"show,omitempty"`
+	DaysPerTurn *int    `json:"daysPerTurn,omitempty"`
+}
+
+// ChallengePerf represents the perf type a Lichess challenge is rated in.
+type ChallengePerf struct {
+	Icon string `json:"icon,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// ChallengeList represents the challenges of the authenticated user.
+type ChallengeList struct {
+	In  []*Challenge `json:"in,omitempty"`
+	Out []*Challenge `json:"out,omitempty"`
+}
+
+// Color represents the color of the pieces of a Lichess player.
+type Color string
+
+const (
+	ColorWhite  Color = "white"
+	ColorBlack  Color = "black"
+	ColorRandom Color = "random"
+)
+
+// DeclineReason represents the reason why a Lichess challenge is declined.
+type DeclineReason string
+
+const (
+	DeclineGeneric     DeclineReason = "generic"
+	DeclineLater       DeclineReason = "later"
+	DeclineTooFast     DeclineReason = "tooFast"
+	DeclineTooSlow     DeclineReason = "tooSlow"
+	DeclineTimeControl DeclineReason = "timeControl"
+	DeclineRated       DeclineReason = "rated"
+	DeclineCasual      DeclineReason = "casual"
+	DeclineStandard    DeclineReason = "standard"
+	DeclineVariant     DeclineReason = "variant"
+	DeclineNoBot       DeclineReason = "noBot"
+	DeclineOnlyBot     DeclineReason = "onlyBot"
+)
+
+// ListChallenges gets the incoming and outgoing challenges of the authenticated user.
+// Find more details at https://lichess.org/api#tag/Challenges/operation/challengeList.
+func (s *ChallengesService) ListChallenges(ctx context.Context) (*ChallengeList, *Response, error) {
+	u := "api/challenge"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var list *ChallengeList
+	resp, err := s.client.Do(req, &list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return list, resp, nil
+}
+
+// CreateChallengeOptions specifies parameters for
+// ChallengesService.CreateChallenge method.
+// Either Clock or Days must be set for a real-time or a
+// correspondence game, otherwise the game is unlimited.
+type CreateChallengeOptions struct {
+	Rated   *bool        `url:"rated,omitempty"`
+	Clock   *GameClock   `url:"clock,omitempty"` // Limit (or Initial) and Increment, in seconds.
+	Days    *int         `url:"days,omitempty"`
+	Color   *Color       `url:"color,omitempty"`
+	Variant *GameVariant `url:"variant,omitempty"`
+	// Fen is the initial position, for Standard or FromPosition variants.
+	Fen *string `url:"fen,omitempty"`
+	// Message sent to the opponent when the game starts,
+	// with {player}, {opponent} and {game} placeholders.
+	Message *string `url:"message,omitempty"`
+	// Rules like "noAbort", "noRematch", "noGiveTime", "noClaimWin" or "noEarlyDraw".
+	Rules []string `url:"rules,omitempty,comma"`
+}
+
+// CreateChallenge challenges the given user to a game.
+// Find more details at https://lichess.org/api#tag/Challenges/operation/challengeCreate.
+func (s *ChallengesService) CreateChallenge(
+	ctx context.Context,
+	username string,
+	opts *CreateChallengeOptions,
+) (*Challenge, *Response, error) {
+	u := fmt.Sprintf("api/challenge/%v", username)
+
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var challenge *Challenge
+	resp, err := s.client.Do(req, &challenge)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return challenge, resp, nil
+}
+
+// AcceptChallenge accepts an incoming challenge.
+// Find more details at https://lichess.org/api#tag/Challenges/operation/challengeAccept.
+func (s *ChallengesService) AcceptChallenge(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("api/challenge/%v/accept", id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
+
+// declineChallengeOptions holds the reason to decline a challenge.
+type declineChallengeOptions struct {
+	Reason DeclineReason `url:"reason,omitempty"`
+}
+
+// DeclineChallenge declines an incoming challenge, with the given
+// reason, which is shown to the challenger. Defaults to [DeclineGeneric] if empty.
+// Find more details at https://lichess.org/api#tag/Challenges/operation/challengeDecline.
+func (s *ChallengesService) DeclineChallenge(ctx context.Context, id string, reason DeclineReason) (*Response, error) {
+	u := fmt.Sprintf("api/challenge/%v/decline", id)
+
+	body, err := formBody(declineChallengeOptions{Reason: reason})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
+
+// CancelChallenge cancels an outgoing challenge, or aborts the game if the
+// challenge was accepted, but the game was not yet played.
+// Find more details at https://lichess.org/api#tag/Challenges/operation/challengeCancel.
+func (s *ChallengesService) CancelChallenge(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("api/challenge/%v/cancel", id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
+
+// ChallengeAIOptions specifies parameters for
+// ChallengesService.ChallengeAI method.
+type ChallengeAIOptions struct {
+	Clock   *GameClock   `url:"clock,omitempty"` // Limit (or Initial) and Increment, in seconds.
+	Days    *int         `url:"days,omitempty"`
+	Color   *Color       `url:"color,omitempty"`
+	Variant *GameVariant `url:"variant,omitempty"`
+	Fen     *string      `url:"fen,omitempty"`
+}
+
+// challengeAIOptions adds the required level to ChallengeAIOptions.
+type challengeAIOptions struct {
+	Level               int `url:"level"`
+	*ChallengeAIOptions `url:",omitempty"`
+}
+
+// AIGame represents a Lichess game against the AI (Stockfish).
+type AIGame struct {
+	Id      string            `json:"id,omitempty"`
+	Variant *ChallengeVariant `json:"variant,omitempty"`
+	Speed   GameSpeed         `json:"speed,omitempty"`
+	Perf    string            `json:"perf,omitempty"`
+	Rated   bool              `json:"rated,omitempty"`
+	Fen     string            `json:"fen,omitempty"`
+	Turns   int               `json:"turns,omitempty"`
+	Source  string            `json:"source,omitempty"`
+	Status  *struct {
+		Id   int        `json:"id,omitempty"`
+		Name GameStatus `json:"name,omitempty"`
+	} `json:"status,omitempty"`
+	CreatedAt int64 `json:"createdAt,omitempty"`
+	Player    Color `json:"player,omitempty"`
+}
+
+// ChallengeAI starts a game against the AI (Stockfish), with
+// the given level, from 1 to 8. The game starts immediately.
+// Find more details at https://lichess.org/api#tag/Challenges/operation/challengeAi.
+func (s *ChallengesService) ChallengeAI(
+	ctx context.Context,
+	level int,
+	opts *ChallengeAIOptions,
+) (*AIGame, *Response, error) {
+	u := "api/challenge/ai"
+
+	body, err := formBody(challengeAIOptions{Level: level, ChallengeAIOptions: opts})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var game *AIGame
+	resp, err := s.client.Do(req, &game)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return game, resp, nil
+}
+
+// CreateOpenChallengeOptions specifies parameters for
+// ChallengesService.CreateOpenChallenge method.
+type CreateOpenChallengeOptions struct {
+	Rated   *bool        `url:"rated,omitempty"`
+	Clock   *GameClock   `url:"clock,omitempty"` // Limit (or Initial) and Increment, in seconds.
+	Days    *int         `url:"days,omitempty"`
+	Variant *GameVariant `url:"variant,omitempty"`
+	Fen     *string      `url:"fen,omitempty"`
+	// Name of the challenge, shown on the challenge page.
+	Name *string `url:"name,omitempty"`
+	// Users restricts who can accept the challenge to the given usernames.
+	Users []string `url:"users,omitempty,comma"`
+	// ExpiresAt is the time (in milliseconds since epoch) at which the
+	// challenge expires, if not accepted. Defaults to 24h after creation.
+	ExpiresAt *int64   `url:"expiresAt,omitempty"`
+	Rules     []string `url:"rules,omitempty,comma"`
+}
+
+// OpenChallenge represents an open-ended Lichess challenge,
+// which can be accepted by anyone (or by the given users).
+type OpenChallenge struct {
+	Challenge
+	UrlWhite string `json:"urlWhite,omitempty"`
+	UrlBlack string `json:"urlBlack,omitempty"`
+}
+
+// CreateOpenChallenge creates a challenge that any user (or
+// the given users) can join, by visiting its URLs.
+// Find more details at https://lichess.org/api#tag/Challenges/operation/challengeOpen.
+func (s *ChallengesService) CreateOpenChallenge(
+	ctx context.Context,
+	opts *CreateOpenChallengeOptions,
+) (*OpenChallenge, *Response, error) {
+	u := "api/challenge/open"
+
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var challenge *OpenChallenge
+	resp, err := s.client.Do(req, &challenge)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return challenge, resp, nil
+}
+
+// startClocksOptions holds the tokens of both players.
+type startClocksOptions struct {
+	Token1 string `url:"token1"`
+	Token2 string `url:"token2,omitempty"`
+}
+
+// StartClocks starts the clocks of a game created with, for instance, an open
+// challenge, immediately, instead of waiting for the players to make a move.
+// token1 and token2 are the OAuth tokens of both players (with challenge:write scope),
+// which are sent as query parameters, so they're redacted from logs and errors.
+// Find more details at https://lichess.org/api#tag/Challenges/operation/challengeStartClocks.
+func (s *ChallengesService) StartClocks(ctx context.Context, gameId, token1, token2 string) (*Response, error) {
+	u, err := addOptions(
+		fmt.Sprintf("api/challenge/%v/start-clocks", gameId),
+		startClocksOptions{Token1: token1, Token2: token2},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
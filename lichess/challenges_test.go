@@ -0,0 +1,267 @@
+package lichess_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestChallengesService_ListChallenges(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/challenge", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"in": [{
+				"id": "abcdefgh",
+				"status": "created",
+				"challenger": {"id": "alice", "name": "Alice", "rating": 1500, "provisional": true},
+				"variant": {"key": "chess960", "name": "Chess960", "short": "960"},
+				"timeControl": {"type": "clock", "limit": 300, "increment": 3, "show": "5+3"},
+				"color": "random",
+				"direction": "in"
+			}],
+			"out": [{"id": "ijklmnop", "variant": "standard", "declineReasonKey": "tooFast"}]
+		}`))
+	})
+
+	list, _, err := client.Challenges.ListChallenges(context.Background())
+	require.NoError(t, err)
+	require.Len(t, list.In, 1)
+	require.Len(t, list.Out, 1)
+
+	in := list.In[0]
+	assert.Equal(t, lichess.ChallengeCreated, in.Status)
+	assert.Equal(t, "Alice", in.Challenger.Name)
+	require.NotNil(t, in.Challenger.Rating)
+	assert.Equal(t, 1500, *in.Challenger.Rating)
+	assert.Equal(t, lichess.Chess960, in.Variant.Key)
+	assert.Equal(t, 300, *in.TimeControl.Limit)
+	assert.Equal(t, lichess.ColorRandom, in.Color)
+	assert.Nil(t, in.DestUser)
+
+	// Some endpoints send the variant as a plain key.
+	out := list.Out[0]
+	assert.Equal(t, lichess.Standard, out.Variant.Key)
+	require.NotNil(t, out.DeclineReasonKey)
+	assert.Equal(t, lichess.DeclineTooFast, *out.DeclineReasonKey)
+}
+
+func TestChallengesService_CreateChallenge(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/challenge/bob", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"rated":           {"true"},
+			"clock.limit":     {"300"},
+			"clock.increment": {"2"},
+			"color":           {"white"},
+			"variant":         {"atomic"},
+			"rules":           {"noAbort,noRematch"},
+		}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{"id":"abcdefgh","status":"created","destUser":{"id":"bob"}}`))
+	})
+
+	rated, limit, increment := true, 300, 2
+	color, variant := lichess.ColorWhite, lichess.Atomic
+
+	challenge, _, err := client.Challenges.CreateChallenge(context.Background(), "bob", &lichess.CreateChallengeOptions{
+		Rated:   &rated,
+		Clock:   &lichess.GameClock{Limit: &limit, Increment: &increment},
+		Color:   &color,
+		Variant: &variant,
+		Rules:   []string{"noAbort", "noRematch"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefgh", challenge.Id)
+	assert.Equal(t, "bob", challenge.DestUser.Id)
+}
+
+func TestChallengesService_actions(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		path     string
+		wantForm url.Values
+		call     func(client *lichess.Client) (*lichess.Response, error)
+	}{
+		"accept": {
+			path: "POST /api/challenge/abcdefgh/accept",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Challenges.AcceptChallenge(context.Background(), "abcdefgh")
+			},
+		},
+		"decline": {
+			path:     "POST /api/challenge/abcdefgh/decline",
+			wantForm: url.Values{"reason": {"tooFast"}},
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Challenges.DeclineChallenge(context.Background(), "abcdefgh", lichess.DeclineTooFast)
+			},
+		},
+		"cancel": {
+			path: "POST /api/challenge/abcdefgh/cancel",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Challenges.CancelChallenge(context.Background(), "abcdefgh")
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc(tc.path, func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				if tc.wantForm != nil {
+					assert.Equal(t, tc.wantForm, r.PostForm)
+				}
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			})
+
+			_, err := tc.call(client)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestChallengesService_ChallengeAI(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/challenge/ai", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"level":           {"3"},
+			"clock.limit":     {"600"},
+			"clock.increment": {"0"},
+		}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{"id":"abcdefgh","variant":{"key":"standard"},"player":"white","turns":0}`))
+	})
+
+	initial, increment := 600, 0
+
+	// Initial is used as the limit, if Limit is nil.
+	game, _, err := client.Challenges.ChallengeAI(context.Background(), 3, &lichess.ChallengeAIOptions{
+		Clock: &lichess.GameClock{Initial: &initial, Increment: &increment},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefgh", game.Id)
+	assert.Equal(t, lichess.ColorWhite, game.Player)
+}
+
+func TestChallengesService_CreateOpenChallenge(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/challenge/open", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"name":  {"Final"},
+			"users": {"alice,bob"},
+		}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{
+			"id": "abcdefgh",
+			"urlWhite": "https://lichess.org/abcdefgh?color=white",
+			"urlBlack": "https://lichess.org/abcdefgh?color=black"
+		}`))
+	})
+
+	name := "Final"
+
+	challenge, _, err := client.Challenges.CreateOpenChallenge(context.Background(),
+		&lichess.CreateOpenChallengeOptions{Name: &name, Users: []string{"alice", "bob"}})
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefgh", challenge.Id)
+	assert.Equal(t, "https://lichess.org/abcdefgh?color=white", challenge.UrlWhite)
+}
+
+func TestChallengesService_StartClocks(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/challenge/abcdefgh/start-clocks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "lip_alice", r.URL.Query().Get("token1"))
+		assert.Equal(t, "lip_bob", r.URL.Query().Get("token2"))
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	_, err := client.Challenges.StartClocks(context.Background(), "abcdefgh", "lip_alice", "lip_bob")
+	require.NoError(t, err)
+}
+
+func TestChallengesService_StartClocks_redactsTokens(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		handler func(w http.ResponseWriter, r *http.Request)
+		logged  bool // Whether the URL is logged.
+	}{
+		"error response": {
+			logged: true,
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"Invalid tokens"}`))
+			},
+		},
+		"rate limit": {
+			logged: true,
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+		},
+		"connection error": {
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				conn, _, err := http.NewResponseController(w).Hijack()
+				if assert.NoError(t, err) {
+					// Explicit ignore error.
+					_ = conn.Close()
+				}
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+
+			client, mux := setup(t)
+			client.Logger = slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			mux.HandleFunc("POST /api/challenge/abcdefgh/start-clocks", tc.handler)
+
+			_, err := client.Challenges.StartClocks(context.Background(), "abcdefgh", "lip_alice", "lip_bob")
+			require.Error(t, err)
+
+			// The cool-down short-circuits the next request, if any.
+			_, nextErr := client.Challenges.StartClocks(context.Background(), "abcdefgh", "lip_alice", "lip_bob")
+			require.Error(t, nextErr)
+
+			assert.Contains(t, err.Error(), "token1=xxxxx")
+			assert.Contains(t, nextErr.Error(), "token2=xxxxx")
+			if tc.logged {
+				assert.Contains(t, logs.String(), "token1=xxxxx")
+			}
+
+			for _, out := range []string{err.Error(), nextErr.Error(), logs.String()} {
+				assert.NotContains(t, out, "lip_alice")
+				assert.NotContains(t, out, "lip_bob")
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package lichess
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Sentinel errors that describe the kind of [ErrorResponse] returned by the
+// Lichess API. Use [errors.Is] to check for them, and [errors.As] to get
+// access to the underlying [ErrorResponse].
+var (
+	ErrBadRequest   = errors.New("lichess: bad request")
+	ErrUnauthorized = errors.New("lichess: unauthorized")
+	ErrForbidden    = errors.New("lichess: forbidden")
+	ErrNotFound     = errors.New("lichess: not found")
+)
+
+// ErrorResponse reports an error caused by an API request.
+// It is returned by [Client.Do] and [Client.BareDo] for any
+// response with a 4xx or 5xx status code, except for HTTP 429
+// responses, which are reported as [*RateLimitError].
+type ErrorResponse struct {
+	Response   *Response // HTTP response that caused this error.
+	StatusCode int       // HTTP status code of the response.
+
+	// Message is the error message returned by Lichess, if any.
+	Message string
+	// Errors holds the per-field validation errors returned by Lichess,
+	// if any. For instance, when a form submitted to the API is invalid.
+	Errors map[string][]string
+}
+
+func (r *ErrorResponse) Error() string {
+	msg := r.Message
+	if msg == "" {
+		msg = http.StatusText(r.StatusCode)
+	}
+
+	if len(r.Errors) > 0 {
+		msg = fmt.Sprintf("%s %v", msg, r.Errors)
+	}
+
+	if r.Response == nil || r.Response.Request == nil {
+		return fmt.Sprintf("%d %s", r.StatusCode, msg)
+	}
+
+	return fmt.Sprintf("%v %v: %d %s",
+		r.Response.Request.Method, redactURL(r.Response.Request.URL), r.StatusCode, msg)
+}
+
+// Is reports whether target is the sentinel error
+// that matches the status code of the response.
+func (r *ErrorResponse) Is(target error) bool {
+	switch r.StatusCode {
+	case http.StatusBadRequest:
+		return target == ErrBadRequest
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	default:
+		return false
+	}
+}
+
+// checkResponse checks the API response for errors, and returns them if
+// present. A response is considered an error if it has a status code outside
+// the 2xx range. The response body is consumed and replaced with an in-memory
+// copy, so it can still be read by the caller.
+func checkResponse(r *Response) error {
+	if c := r.StatusCode; http.StatusOK <= c && c < http.StatusMultipleChoices {
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: r, StatusCode: r.StatusCode}
+
+	data, err := io.ReadAll(r.Body)
+	// Explicit ignore error.
+	// The body has already been read (or failed to).
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	if err == nil && len(data) > 0 {
+		errorResponse.decode(data)
+	}
+
+	if r.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{
+			Response: r,
+			Reset:    r.RateLimit.Reset,
+			Message:  errorResponse.Message,
+		}
+	}
+
+	return errorResponse
+}
+
+// decode decodes the Lichess error body into r. Lichess replies either with
+// {"error": "message"} or, for form validation errors, with
+// {"error": {"field": ["message"]}}. Any other body is ignored.
+func (r *ErrorResponse) decode(data []byte) {
+	var body struct {
+		Error json.RawMessage `json:"error"`
+	}
+
+	if err := json.Unmarshal(data, &body); err != nil || len(body.Error) == 0 {
+		return
+	}
+
+	if err := json.Unmarshal(body.Error, &r.Message); err == nil {
+		return
+	}
+
+	// Explicit ignore error.
+	// Unknown error formats are left undecoded.
+	_ = json.Unmarshal(body.Error, &r.Errors)
+}
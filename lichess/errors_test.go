@@ -0,0 +1,114 @@
+package lichess_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		status   int
+		sentinel error
+	}{
+		"bad request":  {status: http.StatusBadRequest, sentinel: lichess.ErrBadRequest},
+		"unauthorized": {status: http.StatusUnauthorized, sentinel: lichess.ErrUnauthorized},
+		"forbidden":    {status: http.StatusForbidden, sentinel: lichess.ErrForbidden},
+		"not found":    {status: http.StatusNotFound, sentinel: lichess.ErrNotFound},
+		"server error": {status: http.StatusInternalServerError},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc("GET /game/export/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(`{"error":"something went wrong"}`))
+			})
+
+			game, resp, err := client.Games.ExportById(context.Background(), "abcdefgh", nil)
+			require.Error(t, err)
+			assert.Nil(t, game)
+
+			var errResp *lichess.ErrorResponse
+			require.ErrorAs(t, err, &errResp)
+			assert.Equal(t, tc.status, errResp.StatusCode)
+			assert.Equal(t, "something went wrong", errResp.Message)
+			assert.Same(t, resp, errResp.Response)
+			assert.Contains(t, err.Error(), "GET")
+			assert.Contains(t, err.Error(), "something went wrong")
+
+			for _, sentinel := range []error{
+				lichess.ErrBadRequest, lichess.ErrUnauthorized, lichess.ErrForbidden, lichess.ErrNotFound,
+			} {
+				assert.Equal(t, errors.Is(sentinel, tc.sentinel), errors.Is(err, sentinel), sentinel)
+			}
+
+			// The body is still readable by the caller.
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"error":"something went wrong"}`, string(body))
+		})
+	}
+}
+
+func TestErrorResponse_validationErrors(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /game/export/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"moves":["invalid value"]}}`))
+	})
+
+	_, _, err := client.Games.ExportById(context.Background(), "abcdefgh", nil)
+
+	var errResp *lichess.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Empty(t, errResp.Message)
+	assert.Equal(t, map[string][]string{"moves": {"invalid value"}}, errResp.Errors)
+	assert.ErrorIs(t, err, lichess.ErrBadRequest)
+}
+
+func TestErrorResponse_unknownBody(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /game/export/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte(`<html>Bad Gateway</html>`))
+	})
+
+	_, _, err := client.Games.ExportById(context.Background(), "abcdefgh", nil)
+
+	var errResp *lichess.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusBadGateway, errResp.StatusCode)
+	assert.Empty(t, errResp.Message)
+	assert.Contains(t, err.Error(), http.StatusText(http.StatusBadGateway))
+}
+
+func TestDo_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /game/export/abcdefgh", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(`{"id":"abcdefgh"}`))
+	})
+
+	game, _, err := client.Games.ExportById(context.Background(), "abcdefgh", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefgh", game.Id)
+}
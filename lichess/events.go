@@ -0,0 +1,314 @@
+package lichess
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+)
+
+// IncomingEventType represents the type of an event of the
+// stream of incoming events of the authenticated user.
+type IncomingEventType string
+
+const (
+	GameStartEventType         IncomingEventType = "gameStart"
+	GameFinishEventType        IncomingEventType = "gameFinish"
+	ChallengeEventType         IncomingEventType = "challenge"
+	ChallengeCanceledEventType IncomingEventType = "challengeCanceled"
+	ChallengeDeclinedEventType IncomingEventType = "challengeDeclined"
+	IncomingUnknownEventType   IncomingEventType = "unknown"
+	IncomingErrorEventType     IncomingEventType = "error"
+)
+
+// IncomingEvent represents an event of the stream of incoming events of the
+// authenticated user. It is one of [GameStartEvent], [GameFinishEvent],
+// [ChallengeEvent], [ChallengeCanceledEvent], [ChallengeDeclinedEvent],
+// [UnknownIncomingEvent] or [IncomingEventError].
+type IncomingEvent interface {
+	IncomingEventType() IncomingEventType
+}
+
+// EventGame represents a Lichess game, as sent by the stream of incoming events.
+type EventGame struct {
+	Id       string            `json:"id"`
+	GameId   string            `json:"gameId"`
+	FullId   string            `json:"fullId"` // Game id, followed by the player id.
+	Color    Color             `json:"color"`
+	Fen      string            `json:"fen"`
+	HasMoved bool              `json:"hasMoved"`
+	IsMyTurn bool              `json:"isMyTurn"`
+	LastMove string            `json:"lastMove"`
+	Opponent EventGameOpponent `json:"opponent"`
+	Perf     string            `json:"perf"`
+	Rated    bool              `json:"rated"`
+	// SecondsLeft is the remaining time of the user, for real-time games.
+	SecondsLeft *int   `json:"secondsLeft,omitempty"`
+	Source      string `json:"source"`
+	Status      struct {
+		Id   int        `json:"id"`
+		Name GameStatus `json:"name"`
+	} `json:"status"`
+	Speed      GameSpeed         `json:"speed"`
+	Variant    *ChallengeVariant `json:"variant,omitempty"`
+	Compat     *EventCompat      `json:"compat,omitempty"`
+	Winner     *Color            `json:"winner,omitempty"`
+	RatingDiff *int              `json:"ratingDiff,omitempty"`
+}
+
+// EventGameOpponent represents the opponent in a game sent by the
+// stream of incoming events. AI is the level of the AI, if any.
+type EventGameOpponent struct {
+	Id       string `json:"id"`
+	Username string `json:"username"`
+	Rating   *int   `json:"rating,omitempty"`
+	AI       *int   `json:"ai,omitempty"`
+}
+
+// EventCompat reports whether a game or a challenge
+// can be played with the Bot API, or the Board API.
+type EventCompat struct {
+	Bot   bool `json:"bot"`
+	Board bool `json:"board"`
+}
+
+// GameStartEvent is sent when a game of the authenticated user starts,
+// including the games already in progress when the stream starts.
+type GameStartEvent struct {
+	Game *EventGame `json:"game"`
+}
+
+func (e GameStartEvent) IncomingEventType() IncomingEventType {
+	return GameStartEventType
+}
+
+// GameFinishEvent is sent when a game of the authenticated user finishes.
+type GameFinishEvent struct {
+	Game *EventGame `json:"game"`
+}
+
+func (e GameFinishEvent) IncomingEventType() IncomingEventType {
+	return GameFinishEventType
+}
+
+// ChallengeEvent is sent when the authenticated user creates or receives a
+// challenge, including the challenges already pending when the stream starts.
+type ChallengeEvent struct {
+	Challenge *Challenge   `json:"challenge"`
+	Compat    *EventCompat `json:"compat,omitempty"`
+}
+
+func (e ChallengeEvent) IncomingEventType() IncomingEventType {
+	return ChallengeEventType
+}
+
+// ChallengeCanceledEvent is sent when a challenge
+// to the authenticated user is canceled.
+type ChallengeCanceledEvent struct {
+	Challenge *Challenge `json:"challenge"`
+}
+
+func (e ChallengeCanceledEvent) IncomingEventType() IncomingEventType {
+	return ChallengeCanceledEventType
+}
+
+// ChallengeDeclinedEvent is sent when a challenge
+// of the authenticated user is declined.
+type ChallengeDeclinedEvent struct {
+	Challenge *Challenge `json:"challenge"`
+}
+
+func (e ChallengeDeclinedEvent) IncomingEventType() IncomingEventType {
+	return ChallengeDeclinedEventType
+}
+
+// UnknownIncomingEvent represents an incoming event that isn't recognized
+// (yet) by this library. Raw holds the event as sent by Lichess.
+type UnknownIncomingEvent struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (e UnknownIncomingEvent) IncomingEventType() IncomingEventType {
+	return IncomingUnknownEventType
+}
+
+// IncomingEventError represents an incoming event error.
+// It is sent when the stream breaks, as the last event of the stream.
+type IncomingEventError struct {
+	error
+}
+
+func (e IncomingEventError) IncomingEventType() IncomingEventType {
+	return IncomingErrorEventType
+}
+
+// Unwrap returns the error that broke the stream.
+func (e IncomingEventError) Unwrap() error {
+	return e.error
+}
+
+// parseIncomingEvent decodes an incoming event, based on its type.
+func parseIncomingEvent(event []byte) (IncomingEvent, error) {
+	var header struct {
+		Type IncomingEventType `json:"type"`
+	}
+	if err := json.Unmarshal(event, &header); err != nil {
+		return nil, err
+	}
+
+	switch header.Type {
+	case GameStartEventType:
+		return decodeAs[IncomingEvent, GameStartEvent](event)
+	case GameFinishEventType:
+		return decodeAs[IncomingEvent, GameFinishEvent](event)
+	case ChallengeEventType:
+		return decodeAs[IncomingEvent, ChallengeEvent](event)
+	case ChallengeCanceledEventType:
+		return decodeAs[IncomingEvent, ChallengeCanceledEvent](event)
+	case ChallengeDeclinedEventType:
+		return decodeAs[IncomingEvent, ChallengeDeclinedEvent](event)
+	default:
+		return UnknownIncomingEvent{Type: string(header.Type), Raw: rawEvent(event)}, nil
+	}
+}
+
+// streamIncomingEvents streams the [IncomingEvent] of the authenticated user,
+// with the same semantics as [GamesService.StreamGameMoves]. It is shared by
+// the Board API and the Bot API, which use the same endpoint.
+func streamIncomingEvents(ctx context.Context, c *Client) (chan IncomingEvent, *Response, error) {
+	req, err := incomingEventsRequest(ctx, c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	stream := newStream(ctx, resp, parseIncomingEvent)
+	ch := make(chan IncomingEvent)
+
+	go func() {
+		defer close(ch)
+
+		var err error
+		if policy := c.ReconnectPolicy; policy != nil {
+			dedup := newIncomingEventsDedup()
+			err = resumableStream[IncomingEvent]{
+				open: func() (*Stream[IncomingEvent], error) {
+					return openStream(ctx, c, func() (*http.Request, error) {
+						return incomingEventsRequest(ctx, c)
+					}, parseIncomingEvent)
+				},
+				done:    func(IncomingEvent) bool { return false },
+				keep:    dedup.keep,
+				resumed: dedup.resumed,
+			}.run(ctx, policy, stream, sendTo(ctx, ch))
+		} else {
+			err = stream.pipe(ctx, ch)
+		}
+
+		if err != nil {
+			sendTo(ctx, ch)(IncomingEventError{error: err})
+		}
+	}()
+
+	return ch, resp, nil
+}
+
+// incomingEventsDedup reports whether incoming events have to be kept, or discarded
+// because they were already seen before reconnecting: on every connection, Lichess
+// sends the games in progress and the pending challenges again. Games and challenges
+// are tracked along with the last connection they were seen on, so those that ended
+// while disconnected (e.g. expired challenges), which aren't sent again, are evicted
+// on the next reconnection. So, memory is bounded by the games in progress and the
+// pending challenges seen on the last two connections.
+type incomingEventsDedup struct {
+	conn       int            // Current connection, starting at 0.
+	games      map[string]int // Games in progress, by id, to the last connection they were seen on.
+	challenges map[string]int // Pending challenges, by id, to the last connection they were seen on.
+}
+
+func newIncomingEventsDedup() *incomingEventsDedup {
+	return &incomingEventsDedup{games: make(map[string]int), challenges: make(map[string]int)}
+}
+
+// keep reports whether the event has to be kept.
+func (d *incomingEventsDedup) keep(event IncomingEvent) bool {
+	switch e := event.(type) {
+	case GameStartEvent:
+		if e.Game == nil {
+			return true
+		}
+
+		// Accepted challenges become games with the same id.
+		delete(d.challenges, e.Game.GameId)
+
+		return d.see(d.games, e.Game.GameId)
+
+	case GameFinishEvent:
+		if e.Game != nil {
+			delete(d.games, e.Game.GameId)
+		}
+		return true
+
+	case ChallengeEvent:
+		if e.Challenge == nil {
+			return true
+		}
+
+		return d.see(d.challenges, e.Challenge.Id)
+
+	case ChallengeCanceledEvent:
+		if e.Challenge != nil {
+			delete(d.challenges, e.Challenge.Id)
+		}
+		return true
+
+	case ChallengeDeclinedEvent:
+		if e.Challenge != nil {
+			delete(d.challenges, e.Challenge.Id)
+		}
+		return true
+
+	default:
+		return true
+	}
+}
+
+// see records that the game or challenge identified by id has been seen
+// on the current connection, and reports whether it's the first time.
+func (d *incomingEventsDedup) see(seen map[string]int, id string) bool {
+	_, ok := seen[id]
+	seen[id] = d.conn
+
+	return !ok
+}
+
+// resumed evicts the games and challenges that weren't sent again
+// on the previous connection, because they already ended.
+func (d *incomingEventsDedup) resumed() {
+	d.conn++
+
+	for _, seen := range []map[string]int{d.games, d.challenges} {
+		for id, conn := range seen {
+			if conn < d.conn-1 {
+				delete(seen, id)
+			}
+		}
+	}
+}
+
+// incomingEventsSeq returns an iterator over the [IncomingEvent] of the
+// authenticated user, shared by the Board API and the Bot API.
+func incomingEventsSeq(ctx context.Context, c *Client) iter.Seq2[IncomingEvent, error] {
+	return streamSeq(ctx, c, func() (*http.Request, error) {
+		return incomingEventsRequest(ctx, c)
+	}, parseIncomingEvent)
+}
+
+func incomingEventsRequest(ctx context.Context, c *Client) (*http.Request, error) {
+	return c.NewRequest(ctx, http.MethodGet, "api/stream/event")
+}
@@ -0,0 +1,205 @@
+package lichess_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestBotService_StreamEvents(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+
+	mux.HandleFunc("GET /api/stream/event", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"type":"gameStart","game":{"gameId":"game1","fullId":"game1abcd","color":"white",` +
+			`"isMyTurn":true,"opponent":{"id":"bob","username":"Bob","rating":1500},"speed":"blitz",` +
+			`"compat":{"bot":true,"board":false}}}
+
+{"type":"gameFinish","game":{"gameId":"game1","winner":"black","ratingDiff":-8}}
+{"type":"challenge","challenge":{"id":"chal1","status":"created","speed":"rapid"},"compat":{"bot":true}}
+{"type":"challengeCanceled","challenge":{"id":"chal2","status":"canceled"}}
+{"type":"challengeDeclined","challenge":{"id":"chal3","status":"declined","declineReasonKey":"later"}}
+{"type":"newFeature","data":42}
+`))
+	})
+
+	events, _, err := client.Bot.StreamEvents(context.Background())
+	require.NoError(t, err)
+
+	var got []lichess.IncomingEvent
+	for event := range events {
+		got = append(got, event)
+	}
+	require.Len(t, got, 6)
+
+	start, ok := got[0].(lichess.GameStartEvent)
+	require.True(t, ok, "got %T, want GameStartEvent", got[0])
+	assert.Equal(t, "game1", start.Game.GameId)
+	assert.Equal(t, "game1abcd", start.Game.FullId)
+	assert.Equal(t, lichess.ColorWhite, start.Game.Color)
+	assert.True(t, start.Game.IsMyTurn)
+	assert.Equal(t, "Bob", start.Game.Opponent.Username)
+	assert.Equal(t, 1500, *start.Game.Opponent.Rating)
+	assert.Equal(t, lichess.Blitz, start.Game.Speed)
+	assert.Equal(t, &lichess.EventCompat{Bot: true}, start.Game.Compat)
+
+	finish, ok := got[1].(lichess.GameFinishEvent)
+	require.True(t, ok, "got %T, want GameFinishEvent", got[1])
+	assert.Equal(t, "game1", finish.Game.GameId)
+	assert.Equal(t, lichess.ColorBlack, *finish.Game.Winner)
+	assert.Equal(t, -8, *finish.Game.RatingDiff)
+
+	challenge, ok := got[2].(lichess.ChallengeEvent)
+	require.True(t, ok, "got %T, want ChallengeEvent", got[2])
+	assert.Equal(t, "chal1", challenge.Challenge.Id)
+	assert.Equal(t, lichess.ChallengeCreated, challenge.Challenge.Status)
+	assert.Equal(t, lichess.Rapid, challenge.Challenge.Speed)
+	assert.Equal(t, &lichess.EventCompat{Bot: true}, challenge.Compat)
+
+	canceled, ok := got[3].(lichess.ChallengeCanceledEvent)
+	require.True(t, ok, "got %T, want ChallengeCanceledEvent", got[3])
+	assert.Equal(t, "chal2", canceled.Challenge.Id)
+
+	declined, ok := got[4].(lichess.ChallengeDeclinedEvent)
+	require.True(t, ok, "got %T, want ChallengeDeclinedEvent", got[4])
+	assert.Equal(t, "chal3", declined.Challenge.Id)
+	assert.Equal(t, lichess.DeclineReason("later"), *declined.Challenge.DeclineReasonKey)
+
+	unknown, ok := got[5].(lichess.UnknownIncomingEvent)
+	require.True(t, ok, "got %T, want UnknownIncomingEvent", got[5])
+	assert.Equal(t, "newFeature", unknown.Type)
+	assert.JSONEq(t, `{"type":"newFeature","data":42}`, string(unknown.Raw))
+	assert.Equal(t, lichess.IncomingUnknownEventType, unknown.IncomingEventType())
+}
+
+func TestBotService_StreamEvents_error(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+
+	mux.HandleFunc("GET /api/stream/event", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"type":"challenge","challenge":{"id":"chal1"}}
+{"type":"gameStart","game":{"gameId":`))
+	})
+
+	events, _, err := client.Bot.StreamEvents(context.Background())
+	require.NoError(t, err)
+
+	var last lichess.IncomingEvent
+	for event := range events {
+		last = event
+	}
+
+	streamErr, ok := last.(lichess.IncomingEventError)
+	require.True(t, ok, "got %T, want IncomingEventError", last)
+	assert.Equal(t, lichess.IncomingErrorEventType, streamErr.IncomingEventType())
+	require.ErrorIs(t, streamErr, io.ErrUnexpectedEOF)
+}
+
+func TestBoardService_Events(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+
+	mux.HandleFunc("GET /api/stream/event", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"type":"challenge","challenge":{"id":"chal1"}}
+{"type":"gameStart","game":{"gameId":"chal1"}}
+`))
+	})
+
+	var got []lichess.IncomingEventType
+	for event, err := range client.Board.Events(context.Background()) {
+		require.NoError(t, err)
+		got = append(got, event.IncomingEventType())
+	}
+
+	assert.Equal(t, []lichess.IncomingEventType{lichess.ChallengeEventType, lichess.GameStartEventType}, got)
+}
+
+func TestBoardService_Events_errorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+
+	mux.HandleFunc("GET /api/stream/event", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	for _, err := range client.Board.Events(context.Background()) {
+		require.ErrorIs(t, err, lichess.ErrUnauthorized)
+	}
+}
+
+func TestReconnect_StreamEvents(t *testing.T) {
+	t.Parallel()
+
+	client, mux, attempts := setupReconnect(t, 2)
+
+	var calls atomic.Int32
+	mux.HandleFunc("GET /api/stream/event", func(w http.ResponseWriter, _ *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			_, _ = w.Write([]byte(`{"type":"gameStart","game":{"gameId":"game1"}}
+{"type":"challenge","challenge":{"id":"chal1"}}
+{"type":"challenge","challenge":{"id":"chal2"}}
+`))
+		case 2:
+			// Games in progress and pending challenges are sent again
+			// on every connection, but chal2 expired while disconnected.
+			_, _ = w.Write([]byte(`{"type":"gameStart","game":{"gameId":"game1"}}
+{"type":"challenge","challenge":{"id":"chal1"}}
+{"type":"gameFinish","game":{"gameId":"game1"}}
+`))
+		case 3:
+			// Since chal2 wasn't sent on the previous connection, it was
+			// forgotten, so it's yielded again if Lichess ever sends it.
+			_, _ = w.Write([]byte(`{"type":"challenge","challenge":{"id":"chal1"}}
+{"type":"challenge","challenge":{"id":"chal2"}}
+`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+
+	events, _, err := client.Bot.StreamEvents(context.Background())
+	require.NoError(t, err)
+
+	var (
+		got  []string
+		last lichess.IncomingEvent
+	)
+	for event := range events {
+		last = event
+		switch e := event.(type) {
+		case lichess.GameStartEvent:
+			got = append(got, "start/"+e.Game.GameId)
+		case lichess.GameFinishEvent:
+			got = append(got, "finish/"+e.Game.GameId)
+		case lichess.ChallengeEvent:
+			got = append(got, "challenge/"+e.Challenge.Id)
+		case lichess.IncomingEventError:
+			// Checked below, as the last event.
+		default:
+			t.Fatalf("unexpected event: %#v", event)
+		}
+	}
+
+	assert.Equal(t, []string{
+		"start/game1", "challenge/chal1", "challenge/chal2",
+		"finish/game1",
+		"challenge/chal2",
+	}, got)
+	streamErr, ok := last.(lichess.IncomingEventError)
+	require.True(t, ok, "got %T, want IncomingEventError", last)
+	require.ErrorIs(t, streamErr, lichess.ErrForbidden)
+	// Attempts are counted again once the stream is resumed.
+	assert.Equal(t, []int{1, 1, 1}, *attempts)
+}
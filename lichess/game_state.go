@@ -0,0 +1,239 @@
+package lichess
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GameStateEventType represents the type of an event of the game
+// state stream of the Board API, and the Bot API.
+type GameStateEventType string
+
+const (
+	GameFullEventType         GameStateEventType = "gameFull"
+	GameStateUpdateEventType  GameStateEventType = "gameState"
+	ChatLineEventType         GameStateEventType = "chatLine"
+	OpponentGoneEventType     GameStateEventType = "opponentGone"
+	GameStateUnknownEventType GameStateEventType = "unknown"
+	GameStateErrorEventType   GameStateEventType = "error"
+)
+
+// GameStateEvent represents an event of the game state stream of the Board
+// API, and the Bot API. It is one of [GameFull], [GameState], [ChatLine],
+// [OpponentGone], [UnknownGameStateEvent] or [GameStateEventError].
+type GameStateEvent interface {
+	GameStateEventType() GameStateEventType
+}
+
+// GameFull represents the full state of a Lichess game,
+// sent at the beginning of the stream.
+type GameFull struct {
+	Id      string            `json:"id"`
+	Variant *ChallengeVariant `json:"variant,omitempty"`
+	// Clock holds the Initial time and Increment, in milliseconds,
+	// for real-time games.
+	Clock *GameClock `json:"clock,omitempty"`
+	Speed GameSpeed  `json:"speed"`
+	Perf  struct {
+		Name string `json:"name"`
+	} `json:"perf"`
+	Rated        bool       `json:"rated"`
+	CreatedAt    int64      `json:"createdAt"`
+	White        GamePlayer `json:"white"`
+	Black        GamePlayer `json:"black"`
+	InitialFen   string     `json:"initialFen"` // "startpos" for the initial position.
+	State        GameState  `json:"state"`
+	DaysPerTurn  *int       `json:"daysPerTurn,omitempty"`
+	TournamentId *string    `json:"tournamentId,omitempty"`
+}
+
+func (e GameFull) GameStateEventType() GameStateEventType {
+	return GameFullEventType
+}
+
+// GamePlayer represents a player of a Lichess game,
+// either a user or the AI, as sent by the game state stream.
+type GamePlayer struct {
+	Id          string  `json:"id,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Title       *string `json:"title,omitempty"`
+	Rating      *int    `json:"rating,omitempty"`
+	Provisional bool    `json:"provisional,omitempty"`
+	AILevel     *int    `json:"aiLevel,omitempty"`
+}
+
+// GameState represents the current state of a Lichess game,
+// sent every time it changes (e.g. a move is played).
+type GameState struct {
+	Moves     string     `json:"moves"` // In UCI format, separated by spaces.
+	WTime     int        `json:"wtime"` // In milliseconds.
+	BTime     int        `json:"btime"` // In milliseconds.
+	WInc      int        `json:"winc"`  // In milliseconds.
+	BInc      int        `json:"binc"`  // In milliseconds.
+	Status    GameStatus `json:"status"`
+	Winner    *Color     `json:"winner,omitempty"`
+	WDraw     bool       `json:"wdraw,omitempty"`
+	BDraw     bool       `json:"bdraw,omitempty"`
+	WTakeback bool       `json:"wtakeback,omitempty"`
+	BTakeback bool       `json:"btakeback,omitempty"`
+}
+
+func (e GameState) GameStateEventType() GameStateEventType {
+	return GameStateUpdateEventType
+}
+
+// Finished reports whether the game is over.
+func (e GameState) Finished() bool {
+	switch e.Status {
+	case "", Created, Started:
+		return false
+	default:
+		return true
+	}
+}
+
+// ChatRoom represents a Lichess game chat room.
+type ChatRoom string
+
+const (
+	PlayerChatRoom    ChatRoom = "player"
+	SpectatorChatRoom ChatRoom = "spectator"
+)
+
+// ChatLine represents a message posted in the chat of a Lichess game.
+type ChatLine struct {
+	Room     ChatRoom `json:"room"`
+	Username string   `json:"username"`
+	Text     string   `json:"text"`
+}
+
+func (e ChatLine) GameStateEventType() GameStateEventType {
+	return ChatLineEventType
+}
+
+// OpponentGone is sent when the opponent leaves the game, or comes back.
+type OpponentGone struct {
+	Gone bool `json:"gone"`
+	// ClaimWinInSeconds is the remaining time before the victory
+	// can be claimed, if the opponent is gone.
+	ClaimWinInSeconds *int `json:"claimWinInSeconds,omitempty"`
+}
+
+func (e OpponentGone) GameStateEventType() GameStateEventType {
+	return OpponentGoneEventType
+}
+
+// UnknownGameStateEvent represents a game state stream event that isn't
+// recognized (yet) by this library. Raw holds the event as sent by Lichess.
+type UnknownGameStateEvent struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (e UnknownGameStateEvent) GameStateEventType() GameStateEventType {
+	return GameStateUnknownEventType
+}
+
+// GameStateEventError represents a game state stream event error.
+// It is sent when the stream breaks, as the last event of the stream.
+type GameStateEventError struct {
+	error
+}
+
+func (e GameStateEventError) GameStateEventType() GameStateEventType {
+	return GameStateErrorEventType
+}
+
+// Unwrap returns the error that broke the stream.
+func (e GameStateEventError) Unwrap() error {
+	return e.error
+}
+
+// parseGameStateEvent decodes a game state stream event, based on its type.
+func parseGameStateEvent(event []byte) (GameStateEvent, error) {
+	var header struct {
+		Type GameStateEventType `json:"type"`
+	}
+	if err := json.Unmarshal(event, &header); err != nil {
+		return nil, err
+	}
+
+	switch header.Type {
+	case GameFullEventType:
+		return decodeAs[GameStateEvent, GameFull](event)
+	case GameStateUpdateEventType:
+		return decodeAs[GameStateEvent, GameState](event)
+	case ChatLineEventType:
+		return decodeAs[GameStateEvent, ChatLine](event)
+	case OpponentGoneEventType:
+		return decodeAs[GameStateEvent, OpponentGone](event)
+	default:
+		return UnknownGameStateEvent{Type: string(header.Type), Raw: rawEvent(event)}, nil
+	}
+}
+
+// isGameStateFinished reports whether the event tells the game is over,
+// after which Lichess ends the stream.
+func isGameStateFinished(event GameStateEvent) bool {
+	switch e := event.(type) {
+	case GameFull:
+		return e.State.Finished()
+	case GameState:
+		return e.Finished()
+	default:
+		return false
+	}
+}
+
+func isGameFull(event GameStateEvent) bool {
+	_, ok := event.(GameFull)
+	return ok
+}
+
+// streamGameState streams the [GameStateEvent] of the response to the request
+// built by newReq, with the same semantics as [GamesService.StreamGameMoves].
+// On reconnection, there's no need to skip events, because Lichess sends the
+// [GameFull] again, which holds the whole state of the game.
+func streamGameState(
+	ctx context.Context,
+	c *Client,
+	newReq func() (*http.Request, error),
+) (chan GameStateEvent, *Response, error) {
+	req, err := newReq()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	stream := newStream(ctx, resp, parseGameStateEvent)
+	ch := make(chan GameStateEvent)
+
+	go func() {
+		defer close(ch)
+
+		var err error
+		if policy := c.ReconnectPolicy; policy != nil {
+			err = resumableStream[GameStateEvent]{
+				open: func() (*Stream[GameStateEvent], error) {
+					return openStream(ctx, c, newReq, parseGameStateEvent)
+				},
+				done:     isGameStateFinished,
+				keep:     func(GameStateEvent) bool { return true },
+				replayed: isGameFull,
+			}.run(ctx, policy, stream, sendTo(ctx, ch))
+		} else {
+			err = stream.pipe(ctx, ch)
+		}
+
+		if err != nil {
+			sendTo(ctx, ch)(GameStateEventError{error: err})
+		}
+	}()
+
+	return ch, resp, nil
+}
@@ -0,0 +1,155 @@
+package lichess_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+const gameStateStream = `{"type":"gameFull","id":"abcdefgh","variant":{"key":"standard"},` +
+	`"clock":{"initial":300000,"increment":2000},"white":{"id":"alice","name":"Alice","rating":1500},` +
+	`"black":{"aiLevel":3},"initialFen":"startpos","state":{"type":"gameState","moves":"","status":"started"}}
+
+{"type":"gameState","moves":"e2e4","wtime":299000,"btime":300000,"status":"started","wdraw":true}
+{"type":"chatLine","room":"player","username":"alice","text":"hi"}
+{"type":"opponentGone","gone":true,"claimWinInSeconds":10}
+{"type":"somethingNew","value":1}
+{"type":"gameState","moves":"e2e4","status":"resign","winner":"white"}
+`
+
+func TestBoardService_StreamGame(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/board/game/stream/abcdefgh", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(gameStateStream))
+	})
+
+	events, _, err := client.Board.StreamGame(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+
+	var got []lichess.GameStateEvent
+	for event := range events {
+		got = append(got, event)
+	}
+
+	require.Len(t, got, 6)
+
+	full, ok := got[0].(lichess.GameFull)
+	require.True(t, ok, "got %T, want GameFull", got[0])
+	assert.Equal(t, "abcdefgh", full.Id)
+	assert.Equal(t, lichess.Standard, full.Variant.Key)
+	assert.Equal(t, 300000, *full.Clock.Initial)
+	assert.Equal(t, "Alice", full.White.Name)
+	require.NotNil(t, full.Black.AILevel)
+	assert.Equal(t, 3, *full.Black.AILevel)
+	assert.False(t, full.State.Finished())
+
+	state, ok := got[1].(lichess.GameState)
+	require.True(t, ok, "got %T, want GameState", got[1])
+	assert.Equal(t, "e2e4", state.Moves)
+	assert.Equal(t, 299000, state.WTime)
+	assert.True(t, state.WDraw)
+
+	chat, ok := got[2].(lichess.ChatLine)
+	require.True(t, ok, "got %T, want ChatLine", got[2])
+	assert.Equal(t, lichess.ChatLine{Room: lichess.PlayerChatRoom, Username: "alice", Text: "hi"}, chat)
+
+	gone, ok := got[3].(lichess.OpponentGone)
+	require.True(t, ok, "got %T, want OpponentGone", got[3])
+	assert.True(t, gone.Gone)
+	assert.Equal(t, 10, *gone.ClaimWinInSeconds)
+
+	unknown, ok := got[4].(lichess.UnknownGameStateEvent)
+	require.True(t, ok, "got %T, want UnknownGameStateEvent", got[4])
+	assert.Equal(t, "somethingNew", unknown.Type)
+	assert.JSONEq(t, `{"type":"somethingNew","value":1}`, string(unknown.Raw))
+
+	end, ok := got[5].(lichess.GameState)
+	require.True(t, ok, "got %T, want GameState", got[5])
+	assert.True(t, end.Finished())
+	require.NotNil(t, end.Winner)
+	assert.Equal(t, lichess.ColorWhite, *end.Winner)
+}
+
+func TestBoardService_StreamGame_error(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/board/game/stream/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{\"type\":\"gameState\",\"moves\":\"\",\"status\":\"started\"}\n{\"type\":\"gameSt"))
+	})
+
+	events, _, err := client.Board.StreamGame(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+
+	var last lichess.GameStateEvent
+	for event := range events {
+		last = event
+	}
+
+	streamErr, ok := last.(lichess.GameStateEventError)
+	require.True(t, ok, "got %T, want GameStateEventError", last)
+	require.ErrorIs(t, streamErr, io.ErrUnexpectedEOF)
+}
+
+func TestBoardService_StreamGame_reconnect(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	client.ReconnectPolicy = &lichess.ReconnectPolicy{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	var calls atomic.Int32
+	mux.HandleFunc("GET /api/board/game/stream/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			// The connection drops before the game is over.
+			_, _ = w.Write([]byte(`{"type":"gameFull","id":"abcdefgh","state":{"moves":"","status":"started"}}` + "\n"))
+			return
+		}
+		_, _ = w.Write([]byte(`{"type":"gameFull","id":"abcdefgh","state":{"moves":"e2e4","status":"started"}}
+{"type":"gameState","moves":"e2e4","status":"mate"}
+`))
+	})
+
+	events, _, err := client.Board.StreamGame(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+
+	var types []lichess.GameStateEventType
+	for event := range events {
+		types = append(types, event.GameStateEventType())
+	}
+
+	// The full state of the game is sent again on reconnection.
+	assert.Equal(t, []lichess.GameStateEventType{
+		lichess.GameFullEventType, lichess.GameFullEventType, lichess.GameStateUpdateEventType,
+	}, types)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestGameState_Finished(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[lichess.GameStatus]bool{
+		"":                    false,
+		lichess.Created:       false,
+		lichess.Started:       false,
+		lichess.Mate:          true,
+		lichess.Resign:        true,
+		lichess.Draw:          true,
+		lichess.Aborted:       true,
+		lichess.UnknownFinish: true,
+	}
+
+	for status, want := range tcs {
+		assert.Equal(t, want, lichess.GameState{Status: status}.Finished(), status)
+	}
+}
@@ -1,5 +1,11 @@
 package lichess
 
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
 // GamesService handles communication with the game related
 // methods of the Lichess API.
 //
@@ -27,8 +33,8 @@ type Game struct {
 	Pgn         *string         `json:"pgn,omitempty"`
 	DaysPerTurn *int            `json:"daysPerTurn,omitempty"`
 	Analysis    []*GameAnalysis `json:"analysis,omitempty"`
-	Tournament  *string         `json:"tournament,omitempty"`
-	Swiss       *string         `json:"swiss,omitempty"`
+	Tournament  *string         `json:"tournament,omitempty"` // See TournamentsService.
+	Swiss       *string         `json:"swiss,omitempty"`      // See SwissService.
 	Clock       *GameClock      `json:"clock,omitempty"`
 }
 
@@ -60,6 +66,33 @@ const (
 	Correspondence GameSpeed = "correspondence"
 )
 
+// PerfType represents a Lichess perf type, the key under which ratings and
+// statistics are tracked. It is either a [GameSpeed] or a [GameVariant].
+type PerfType interface {
+	perfType() string
+}
+
+func (s GameSpeed) perfType() string {
+	return string(s)
+}
+
+func (v GameVariant) perfType() string {
+	return string(v)
+}
+
+// validPerfType checks that p is one of the perf types known by Lichess,
+// which excludes the Standard and FromPosition variants, because the
+// ratings of standard games are tracked per speed.
+func validPerfType(p PerfType) error {
+	switch p {
+	case UltraBullet, Bullet, Blitz, Rapid, Classical, Correspondence,
+		Chess960, Crazyhouse, Antichess, Atomic, Horde, KingOfTheHill, RacingKings, ThreeCheck:
+		return nil
+	default:
+		return fmt.Errorf("invalid perf type: %v", p)
+	}
+}
+
 // GameStatus represents a Lichess game status.
 type GameStatus string
 
@@ -88,7 +121,7 @@ type GameUser struct {
 	Provisional *bool             `json:"provisional,omitempty"`
 	AILevel     *int              `json:"aiLevel,omitempty"`
 	Analysis    *GameUserAnalysis `json:"analysis,omitempty"`
-	Team        *string           `json:"team,omitempty"`
+	Team        *string           `json:"team,omitempty"` // See TeamsService.GetGameUserTeam.
 }
 
 // GameUserAnalysis represents a Lichess game user analysis.
@@ -127,3 +160,27 @@ type GameClock struct {
 	TotalTime *int `json:"totalTime,omitempty"`
 	Limit     *int `json:"limit,omitempty"`
 }
+
+// EncodeValues encodes the clock as the key.limit and key.increment
+// parameters (in seconds), used for instance to create challenges.
+// Initial is used as the limit, if Limit is nil.
+func (c *GameClock) EncodeValues(key string, v *url.Values) error {
+	if c == nil {
+		return nil
+	}
+
+	limit := c.Limit
+	if limit == nil {
+		limit = c.Initial
+	}
+
+	if limit != nil {
+		v.Set(key+".limit", strconv.Itoa(*limit))
+	}
+
+	if c.Increment != nil {
+		v.Set(key+".increment", strconv.Itoa(*c.Increment))
+	}
+
+	return nil
+}
@@ -1,11 +1,11 @@
 package lichess
 
 import (
-	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
@@ -16,16 +16,20 @@ type GameStreamEventType string
 const (
 	GameDescriptionEventType GameStreamEventType = "description"
 	GameMoveEventType        GameStreamEventType = "move"
+	GameFinishedEventType    GameStreamEventType = "finished"
+	GameUnknownEventType     GameStreamEventType = "unknown"
 	GameStreamErrorEventType GameStreamEventType = "error"
 )
 
 // GameStreamEvent represents a Lichess game stream event.
+// It is one of [GameDescription], [GameMove], [GameFinished],
+// [UnknownGameStreamEvent] or [GameStreamEventError].
 type GameStreamEvent interface {
 	GameStreamEventType() GameStreamEventType
 }
 
 // GameDescription the description of a Lichess game
-// sent at the beginning and end of stream.
+// sent at the beginning of stream.
 type GameDescription struct {
 	Id      string `json:"id"`
 	Variant struct {
@@ -41,6 +45,7 @@ type GameDescription struct {
 	StartedAtTurn int       `json:"startedAtTurn"`
 	Source        string    `json:"source"`
 	Status        struct {
+		Id   int        `json:"id"`
 		Name GameStatus `json:"name"`
 	} `json:"status"`
 	CreatedAt int64  `json:"createdAt"`
@@ -55,6 +60,8 @@ func (e GameDescription) GameStreamEventType() GameStreamEventType {
 	return GameDescriptionEventType
 }
 
+// GameMove represents a move played in a Lichess game,
+// along with the resulting position and the remaining clocks.
 type GameMove struct {
 	Fen string `json:"fen"`
 	LM  string `json:"lm"`
@@ -66,7 +73,29 @@ func (e GameMove) GameStreamEventType() GameStreamEventType {
 	return GameMoveEventType
 }
 
+// GameFinished the description of a Lichess game
+// sent at the end of stream, once the game is over.
+type GameFinished struct {
+	GameDescription
+	Winner string `json:"winner,omitempty"` // Either "white" or "black", empty on draws.
+}
+
+func (e GameFinished) GameStreamEventType() GameStreamEventType {
+	return GameFinishedEventType
+}
+
+// UnknownGameStreamEvent represents a Lichess game stream event that isn't
+// recognized (yet) by this library. Raw holds the event as sent by Lichess.
+type UnknownGameStreamEvent struct {
+	Raw json.RawMessage
+}
+
+func (e UnknownGameStreamEvent) GameStreamEventType() GameStreamEventType {
+	return GameUnknownEventType
+}
+
 // GameStreamEventError represents a Lichess game stream event error.
+// It is sent when the stream breaks, as the last event of the stream.
 type GameStreamEventError struct {
 	error
 }
@@ -75,14 +104,22 @@ func (e GameStreamEventError) GameStreamEventType() GameStreamEventType {
 	return GameStreamErrorEventType
 }
 
+// Unwrap returns the error that broke the stream.
+func (e GameStreamEventError) Unwrap() error {
+	return e.error
+}
+
 // StreamGameMoves streams [GameStreamEvent] happening at [Game] identified by id.
 // It closes the channel of [GameStreamEvent] and the [Response] body when the context is done.
 // So, please use the [context.Context] argument to control the lifetime of the stream.
+// If the stream breaks (e.g. a malformed or truncated line, or a connection error),
+// a [GameStreamEventError] is sent as the last event before closing the channel,
+// unless the client has a [Client.ReconnectPolicy], in which case the stream is
+// transparently resumed: the [GameDescription] is sent again, to resynchronise
+// state, and the moves already sent are skipped.
 // Find more details at https://lichess.org/api#tag/Games/operation/streamGame.
 func (s *GamesService) StreamGameMoves(ctx context.Context, id string) (chan GameStreamEvent, *Response, error) {
-	u := fmt.Sprintf("api/stream/game/%v", id)
-
-	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	req, err := s.gameMovesRequest(ctx, id)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,135 +129,193 @@ func (s *GamesService) StreamGameMoves(ctx context.Context, id string) (chan Gam
 		return nil, resp, err
 	}
 
+	stream := newStream(ctx, resp, s.parseGameStreamEvent)
 	ch := make(chan GameStreamEvent)
 
-	go s.streamGameMoves(ctx, ch, resp)
+	go func() {
+		defer close(ch)
+
+		var err error
+		if policy := s.client.ReconnectPolicy; policy != nil {
+			err = resumableStream[GameStreamEvent]{
+				open: func() (*Stream[GameStreamEvent], error) {
+					return openStream(ctx, s.client, func() (*http.Request, error) {
+						return s.gameMovesRequest(ctx, id)
+					}, s.parseGameStreamEvent)
+				},
+				done:     isGameFinished,
+				keep:     newGameMovesDedup(),
+				replayed: isGameDescription,
+			}.run(ctx, policy, stream, sendTo(ctx, ch))
+		} else {
+			err = stream.pipe(ctx, ch)
+		}
+
+		if err != nil {
+			sendTo(ctx, ch)(GameStreamEventError{error: err})
+		}
+	}()
 
 	return ch, resp, nil
 }
 
-func (s *GamesService) streamGameMoves(ctx context.Context, ch chan GameStreamEvent, resp *Response) {
-	defer func() {
-		// Explicit ignore error.
-		// We might want to revisit this later.
-		_ = resp.Body.Close()
-		close(ch)
-	}()
+func isGameFinished(event GameStreamEvent) bool {
+	_, ok := event.(GameFinished)
+	return ok
+}
 
-	scanner := bufio.NewScanner(resp.Body)
+func isGameDescription(event GameStreamEvent) bool {
+	_, ok := event.(GameDescription)
+	return ok
+}
 
-	for scanner.Scan() {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
+// newGameMovesDedup returns a function that reports whether a game stream event has
+// to be kept, or discarded because it was already seen before reconnecting. Moves are
+// identified by their ply and position, and game descriptions are always kept, so
+// consumers can resynchronise their state.
+func newGameMovesDedup() func(GameStreamEvent) bool {
+	var (
+		ply  int
+		fen  string
+		seen = make(map[int]string)
+	)
+
+	return func(event GameStreamEvent) bool {
+		switch e := event.(type) {
+		case GameDescription:
+			ply, fen = e.Turns, fenPosition(e.Fen)
+			seen[ply] = fen
+			return true
+
+		case GameMove:
+			next := fenPosition(e.Fen)
+			if next == fen {
+				return false // The current position, sent again.
+			}
+
+			ply, fen = ply+1, next
+			if seen[ply] == fen {
+				return false
+			}
+
+			seen[ply] = fen
+			return true
 
-		select {
-		case <-ctx.Done():
-			return
-		case ch <- s.parseGameStreamEvent(scanner.Text()):
+		default:
+			return true
 		}
 	}
+}
 
-	if scanner.Err() != nil {
-		select {
-		case <-ctx.Done():
-		case ch <- GameStreamEventError{error: scanner.Err()}:
-		}
+// fenPosition returns the piece placement and side to move of a FEN,
+// the only fields present in every FEN sent by the game streams.
+func fenPosition(fen string) string {
+	fields := strings.Fields(fen)
+	if len(fields) > 2 {
+		fields = fields[:2]
 	}
+
+	return strings.Join(fields, " ")
+}
+
+// GameMoves returns an iterator over the [GameStreamEvent] happening at [Game] identified by id.
+// Equivalent to [GamesService.StreamGameMoves] but with range-over-func semantics.
+func (s *GamesService) GameMoves(ctx context.Context, id string) iter.Seq2[GameStreamEvent, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.gameMovesRequest(ctx, id)
+	}, s.parseGameStreamEvent)
 }
 
-func (s *GamesService) parseGameStreamEvent(event string) GameStreamEvent {
+func (s *GamesService) gameMovesRequest(ctx context.Context, id string) (*http.Request, error) {
+	u := fmt.Sprintf("api/stream/game/%v", id)
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
+
+// parseGameStreamEvent decodes a game stream event, based on the fields it has:
+// game descriptions have an "id", and moves have a "fen" but no "id".
+func (s *GamesService) parseGameStreamEvent(event []byte) (GameStreamEvent, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(event, &fields); err != nil {
+		return nil, err
+	}
+
+	_, hasId := fields["id"]
+	_, hasFen := fields["fen"]
+
 	switch {
-	case strings.Contains(event, `"wc":`):
-		var gameMove GameMove
-		if err := json.Unmarshal([]byte(event), &gameMove); err != nil {
-			return GameStreamEventError{error: err}
+	case hasId:
+		var gameFinished GameFinished
+		if err := json.Unmarshal(event, &gameFinished); err != nil {
+			return nil, err
 		}
-		return gameMove
 
-	case strings.Contains(event, `"variant":`):
-		var gameDescription GameDescription
-		if err := json.Unmarshal([]byte(event), &gameDescription); err != nil {
-			return GameStreamEventError{error: err}
+		switch gameFinished.Status.Name {
+		case "", Created, Started:
+			return gameFinished.GameDescription, nil
+		default:
+			return gameFinished, nil
 		}
-		return gameDescription
+
+	case hasFen:
+		return decodeAs[GameStreamEvent, GameMove](event)
 
 	default:
-		return GameStreamEventError{error: fmt.Errorf(event)}
+		return UnknownGameStreamEvent{Raw: rawEvent(event)}, nil
 	}
 }
 
 // StreamUserGames streams [Game] played by the given username.
 // It closes the channel of [Game] and the [Response] body when the context is done.
 // So, please use the [context.Context] argument to control the lifetime of the stream.
+// The channel of errors receives at most one error, if the stream breaks (e.g. a malformed
+// or truncated line, or a connection error), and it is closed when the stream ends.
 // Equivalent to [GamesService.ExportByUsername] but streams the response.
 // Find more details at https://lichess.org/api#tag/Games/operation/apiGamesUser.
 func (s *GamesService) StreamUserGames(
 	ctx context.Context,
 	username string,
 	opts *ExportByUsernameOptions,
-) (chan *Game, *Response, error) {
-	u := fmt.Sprintf("api/games/user/%v", username)
-	u, err := addOptions(u, opts)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+) (chan *Game, chan error, *Response, error) {
+	req, err := s.userGamesRequest(ctx, username, opts)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
-		return nil, resp, err
+		return nil, nil, resp, err
 	}
 
-	ch := make(chan *Game)
+	ch, errs := NewStream[*Game](ctx, resp).channels(ctx)
 
-	go s.streamUserGames(ctx, ch, resp)
-
-	return ch, resp, nil
+	return ch, errs, resp, nil
 }
 
-func (s *GamesService) streamUserGames(ctx context.Context, ch chan *Game, resp *Response) {
-	defer func() {
-		// Explicit ignore error.
-		// We might want to revisit this later.
-		_ = resp.Body.Close()
-		close(ch)
-	}()
-
-	scanner := bufio.NewScanner(resp.Body)
-
-	for scanner.Scan() {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
-
-		var game Game
-		if err := json.Unmarshal([]byte(scanner.Text()), &game); err != nil {
-			// We might want to revisit the error handling here
-			continue
-		}
+// UserGames returns an iterator over the [Game] played by the given username.
+// Equivalent to [GamesService.StreamUserGames] but with range-over-func semantics.
+func (s *GamesService) UserGames(
+	ctx context.Context,
+	username string,
+	opts *ExportByUsernameOptions,
+) iter.Seq2[*Game, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.userGamesRequest(ctx, username, opts)
+	}, decodeJson[*Game])
+}
 
-		select {
-		case <-ctx.Done():
-			return
-		case ch <- &game:
-		}
+func (s *GamesService) userGamesRequest(
+	ctx context.Context,
+	username string,
+	opts *ExportByUsernameOptions,
+) (*http.Request, error) {
+	u := fmt.Sprintf("api/games/user/%v", username)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	/*
-		if scanner.Err() != nil {
-			// We might want to revisit the
-			// use of scanner.Err().
-		}
-	*/
+	return s.client.NewRequest(ctx, http.MethodGet, u)
 }
 
 // StreamGamesOfUsersOptions specifies parameters for
@@ -250,71 +345,131 @@ type GameStream struct {
 // StreamGamesOfUsers streams [Game] played among the given usernames.
 // It closes the channel of [Game] and the [Response] body when the context is done.
 // So, please use the [context.Context] argument to control the lifetime of the stream.
+// The channel of errors receives at most one error, if the stream breaks (e.g. a malformed
+// or truncated line, or a connection error), and it is closed when the stream ends.
+// If the client has a [Client.ReconnectPolicy], the stream is transparently resumed
+// instead, and the games already sent, with the same status, are skipped.
 // Find more details at https://lichess.org/api#tag/Games/operation/gamesByUsers.
 func (s *GamesService) StreamGamesOfUsers(
 	ctx context.Context,
 	usernames []string,
 	opts *StreamGamesOfUsersOptions,
-) (chan *GameStream, *Response, error) {
-	u, err := addOptions("api/stream/games-by-users", opts)
+) (chan *GameStream, chan error, *Response, error) {
+	req, err := s.gamesOfUsersRequest(ctx, usernames, opts)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, RequestBody{
-		Bytes: bytes.NewReader([]byte(strings.Join(usernames, ","))),
-		Type:  "text/plain",
-	})
+	resp, err := s.client.Do(req, nil)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, resp, err
 	}
 
-	resp, err := s.client.Do(req, nil)
-	if err != nil {
-		return nil, resp, err
+	policy := s.client.ReconnectPolicy
+	if policy == nil {
+		ch, errs := NewStream[*GameStream](ctx, resp).channels(ctx)
+		return ch, errs, resp, nil
 	}
 
 	ch := make(chan *GameStream)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		dedup := newGamesOfUsersDedup()
+		err := resumableStream[*GameStream]{
+			open: func() (*Stream[*GameStream], error) {
+				return openStream(ctx, s.client, func() (*http.Request, error) {
+					return s.gamesOfUsersRequest(ctx, usernames, opts)
+				}, decodeJson[*GameStream])
+			},
+			done:    func(*GameStream) bool { return false },
+			keep:    dedup.keep,
+			resumed: dedup.resumed,
+		}.run(ctx, policy, NewStream[*GameStream](ctx, resp), sendTo(ctx, ch))
+		if err != nil {
+			errs <- err
+		}
+	}()
 
-	go s.streamGamesOfUsers(ctx, ch, resp)
+	return ch, errs, resp, nil
+}
 
-	return ch, resp, nil
+// gamesOfUsersDedup reports whether games have to be kept, or discarded because
+// they were already seen, with the same status, before reconnecting: on every
+// connection, Lichess sends the current games again. Finished games are forgotten,
+// as they aren't sent again, and current games are tracked along with the last
+// connection they were seen on, so those that finished while disconnected are
+// evicted on the next reconnection, like [incomingEventsDedup] does.
+type gamesOfUsersDedup struct {
+	conn  int                         // Current connection, starting at 0.
+	games map[string]gamesOfUsersSeen // Current games, by id.
 }
 
-func (s *GamesService) streamGamesOfUsers(ctx context.Context, ch chan *GameStream, resp *Response) {
-	defer func() {
-		// Explicit ignore error.
-		// We might want to revisit this later.
-		_ = resp.Body.Close()
-		close(ch)
-	}()
+// gamesOfUsersSeen is the status of a current game
+// and the last connection it was seen on.
+type gamesOfUsersSeen struct {
+	status GameStatus
+	conn   int
+}
 
-	scanner := bufio.NewScanner(resp.Body)
+func newGamesOfUsersDedup() *gamesOfUsersDedup {
+	return &gamesOfUsersDedup{games: make(map[string]gamesOfUsersSeen)}
+}
 
-	for scanner.Scan() {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
+// keep reports whether the game has to be kept.
+func (d *gamesOfUsersDedup) keep(game *GameStream) bool {
+	switch game.StatusName {
+	case "", Created, Started:
+		seen, ok := d.games[game.Id]
+		d.games[game.Id] = gamesOfUsersSeen{status: game.StatusName, conn: d.conn}
 
-		var gameStream GameStream
-		if err := json.Unmarshal([]byte(scanner.Text()), &gameStream); err != nil {
-			// We might want to revisit the error handling here
-			continue
-		}
+		return !ok || seen.status != game.StatusName
+	default:
+		// Finished games aren't sent again.
+		delete(d.games, game.Id)
+		return true
+	}
+}
+
+// resumed evicts the games that weren't sent again
+// on the previous connection, because they already finished.
+func (d *gamesOfUsersDedup) resumed() {
+	d.conn++
 
-		select {
-		case <-ctx.Done():
-			return
-		case ch <- &gameStream:
+	for id, seen := range d.games {
+		if seen.conn < d.conn-1 {
+			delete(d.games, id)
 		}
 	}
+}
 
-	/*
-		if scanner.Err() != nil {
-			// We might want to revisit the
-			// use of scanner.Err().
-		}
-	*/
+// GamesOfUsers returns an iterator over the [GameStream] played among the given usernames.
+// Equivalent to [GamesService.StreamGamesOfUsers] but with range-over-func semantics.
+func (s *GamesService) GamesOfUsers(
+	ctx context.Context,
+	usernames []string,
+	opts *StreamGamesOfUsersOptions,
+) iter.Seq2[*GameStream, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.gamesOfUsersRequest(ctx, usernames, opts)
+	}, decodeJson[*GameStream])
+}
+
+func (s *GamesService) gamesOfUsersRequest(
+	ctx context.Context,
+	usernames []string,
+	opts *StreamGamesOfUsersOptions,
+) (*http.Request, error) {
+	u, err := addOptions("api/stream/games-by-users", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequestWithBody(ctx, http.MethodPost, u, RequestBody{
+		Bytes: bytes.NewReader([]byte(strings.Join(usernames, ","))),
+		Type:  "text/plain",
+	})
 }
@@ -0,0 +1,260 @@
+package lichess_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestGamesService_StreamUserGames(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		body    string
+		wantIds []string
+		wantErr func(t *testing.T, err error)
+	}{
+		"finished": {
+			body:    "{\"id\":\"game1\"}\n\n{\"id\":\"game2\"}\n",
+			wantIds: []string{"game1", "game2"},
+			wantErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		"malformed line": {
+			body:    "{\"id\":\"game1\"}\nnot json\n{\"id\":\"game2\"}\n",
+			wantIds: []string{"game1"},
+			wantErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+			},
+		},
+		"truncated": {
+			body:    "{\"id\":\"game1\"}\n{\"id\":\"ga",
+			wantIds: []string{"game1"},
+			wantErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc("GET /api/games/user/chucknorris", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+				assert.Equal(t, "10", r.URL.Query().Get("max"))
+				_, _ = w.Write([]byte(tc.body))
+			})
+
+			maxGames := 10
+			games, errs, _, err := client.Games.StreamUserGames(
+				context.Background(), "chucknorris", &lichess.ExportByUsernameOptions{Max: &maxGames})
+			require.NoError(t, err)
+
+			var ids []string
+			for game := range games {
+				ids = append(ids, game.Id)
+			}
+
+			assert.Equal(t, tc.wantIds, ids)
+			tc.wantErr(t, <-errs)
+
+			_, open := <-errs
+			assert.False(t, open, "the channel of errors must be closed")
+		})
+	}
+}
+
+func TestGamesService_StreamUserGames_errorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/games/user/nobody", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	games, errs, _, err := client.Games.StreamUserGames(context.Background(), "nobody", nil)
+	require.ErrorIs(t, err, lichess.ErrNotFound)
+	assert.Nil(t, games)
+	assert.Nil(t, errs)
+}
+
+func TestGamesService_StreamGamesOfUsers(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/stream/games-by-users", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice,bob", string(body))
+		assert.Equal(t, "true", r.URL.Query().Get("withCurrentGames"))
+
+		_, _ = w.Write([]byte("{\"id\":\"game1\",\"statusName\":\"started\"}\n{\"id\":"))
+	})
+
+	current := true
+	games, errs, _, err := client.Games.StreamGamesOfUsers(context.Background(), []string{"alice", "bob"},
+		&lichess.StreamGamesOfUsersOptions{CurrentGames: &current})
+	require.NoError(t, err)
+
+	var ids []string
+	for game := range games {
+		ids = append(ids, game.Id)
+	}
+
+	assert.Equal(t, []string{"game1"}, ids)
+	require.ErrorIs(t, <-errs, io.ErrUnexpectedEOF)
+}
+
+func TestGamesService_UserGames(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/games/user/chucknorris", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{\"id\":\"game1\"}\n{\"id\":\"game2\"}\n{\"id\":\"ga"))
+	})
+
+	var (
+		ids     []string
+		lastErr error
+	)
+
+	for game, err := range client.Games.UserGames(context.Background(), "chucknorris", nil) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		ids = append(ids, game.Id)
+	}
+
+	assert.Equal(t, []string{"game1", "game2"}, ids)
+	require.ErrorIs(t, lastErr, io.ErrUnexpectedEOF)
+}
+
+func TestGamesService_UserGames_break(t *testing.T) {
+	t.Parallel()
+
+	closed := make(chan struct{})
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/games/user/chucknorris", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{\"id\":\"game1\"}\n"))
+		// Explicit ignore error.
+		_ = http.NewResponseController(w).Flush()
+
+		// The stream never ends, unless the client goes away.
+		<-r.Context().Done()
+		close(closed)
+	})
+
+	for game, err := range client.Games.UserGames(context.Background(), "chucknorris", nil) {
+		require.NoError(t, err)
+		assert.Equal(t, "game1", game.Id)
+
+		break
+	}
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the connection wasn't closed")
+	}
+}
+
+func TestGamesService_UserGames_errorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/games/user/chucknorris", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var calls int
+	for game, err := range client.Games.UserGames(context.Background(), "chucknorris", nil) {
+		calls++
+		assert.Nil(t, game)
+		require.ErrorIs(t, err, lichess.ErrNotFound)
+	}
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestGamesService_StreamGameMoves(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/stream/game/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"abcdefgh","variant":{"key":"standard"},"status":{"id":20,"name":"started"},"turns":0}
+{"fen":"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1","lm":"e2e4","wc":180,"bc":180}
+{"type":"somethingNew","text":"100%v %s"}
+
+{"id":"abcdefgh","status":{"id":31,"name":"resign"},"winner":"black","turns":1}
+`))
+	})
+
+	events, _, err := client.Games.StreamGameMoves(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+
+	var got []lichess.GameStreamEvent
+	for event := range events {
+		got = append(got, event)
+	}
+
+	require.Len(t, got, 4)
+
+	description, ok := got[0].(lichess.GameDescription)
+	require.True(t, ok, "got %T, want GameDescription", got[0])
+	assert.Equal(t, "abcdefgh", description.Id)
+	assert.Equal(t, lichess.Started, description.Status.Name)
+
+	move, ok := got[1].(lichess.GameMove)
+	require.True(t, ok, "got %T, want GameMove", got[1])
+	assert.Equal(t, "e2e4", move.LM)
+	assert.Equal(t, 180, move.WC)
+
+	unknown, ok := got[2].(lichess.UnknownGameStreamEvent)
+	require.True(t, ok, "got %T, want UnknownGameStreamEvent", got[2])
+	assert.JSONEq(t, `{"type":"somethingNew","text":"100%v %s"}`, string(unknown.Raw))
+
+	finished, ok := got[3].(lichess.GameFinished)
+	require.True(t, ok, "got %T, want GameFinished", got[3])
+	assert.Equal(t, lichess.GameStatus("resign"), finished.Status.Name)
+	assert.Equal(t, "black", finished.Winner)
+	assert.Equal(t, lichess.GameFinishedEventType, finished.GameStreamEventType())
+}
+
+func TestGamesService_StreamGameMoves_error(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/stream/game/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{\"id\":\"abcdefgh\"}\n{\"fen\":\"8/8"))
+	})
+
+	events, _, err := client.Games.StreamGameMoves(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+
+	var got []lichess.GameStreamEvent
+	for event := range events {
+		got = append(got, event)
+	}
+
+	require.Len(t, got, 2)
+	assert.IsType(t, lichess.GameDescription{}, got[0])
+
+	streamErr, ok := got[1].(lichess.GameStreamEventError)
+	require.True(t, ok, "got %T, want GameStreamEventError", got[1])
+	require.ErrorIs(t, streamErr, io.ErrUnexpectedEOF)
+}
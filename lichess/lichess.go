@@ -1,22 +1,25 @@
 package lichess
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
 
 const (
-	defaultBaseURL = "https://lichess.org/"
+	defaultBaseURL   = "https://lichess.org/"
+	defaultUserAgent = "go-lichess"
 )
 
 // NewClient returns a new Lichess API client. If a nil httpClient is
@@ -28,31 +31,78 @@ func NewClient(httpClient *http.Client) *Client {
 
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL}
+	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: defaultUserAgent, rateLimiter: &rateLimiter{}}
+	c.initialize()
+
+	return c
+}
+
+// initialize sets up the services of the client.
+func (c *Client) initialize() {
 	c.common.client = c
 	c.Games = (*GamesService)(&c.common)
 	c.Puzzles = (*PuzzlesService)(&c.common)
+	c.Users = (*UsersService)(&c.common)
+	c.Account = (*AccountService)(&c.common)
+	c.OAuth = (*OAuthService)(&c.common)
+	c.Challenges = (*ChallengesService)(&c.common)
+	c.Board = (*BoardService)(&c.common)
+	c.Bot = (*BotService)(&c.common)
+	c.Tournaments = (*TournamentsService)(&c.common)
+	c.Swiss = (*SwissService)(&c.common)
+	c.Teams = (*TeamsService)(&c.common)
+	c.Broadcasts = (*BroadcastsService)(&c.common)
+}
 
-	return c
+// copy returns a copy of the client, with its own services and http.Client,
+// so it can be configured without affecting the original one. The rate limit
+// state is shared, because Lichess rate limits all the requests coming from
+// the same IP address, so a cool-down applies to the original client too.
+func (c *Client) copy() *Client {
+	// Copy the http.Client, so its settings are preserved,
+	// but changes to them (if any) do not affect the original one.
+	httpClient := *c.client
+
+	clone := &Client{
+		client:          &httpClient,
+		UserAgent:       c.UserAgent,
+		Header:          c.Header.Clone(),
+		Timeout:         c.Timeout,
+		MaxLineSize:     c.MaxLineSize,
+		RetryPolicy:     c.RetryPolicy,
+		ReconnectPolicy: c.ReconnectPolicy,
+		RateLimitPolicy: c.RateLimitPolicy,
+		CheckScopes:     c.CheckScopes,
+		Logger:          c.Logger,
+		tokenSource:     c.tokenSource,
+		rateLimiter:     c.rateLimiter,
+	}
+
+	if c.BaseURL != nil {
+		baseURL := *c.BaseURL
+		clone.BaseURL = &baseURL
+	}
+
+	clone.initialize()
+
+	return clone
 }
 
 // WithAuthToken returns a copy of the client configured to use the
-// provided token for the Authorization header.
+// provided token for the Authorization header. The original client,
+// as well as its http.Client, is left untouched.
 func (c *Client) WithAuthToken(token string) *Client {
-	transport := c.client.Transport
-	if transport == nil {
-		transport = http.DefaultTransport
-	}
+	return c.WithTokenSource(staticTokenSource(token))
+}
 
-	c.client.Transport = roundTripperFunc(
-		func(req *http.Request) (*http.Response, error) {
-			req = req.Clone(req.Context())
-			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-			return transport.RoundTrip(req)
-		},
-	)
+// WithTokenSource returns a copy of the client configured to use the
+// tokens supplied by ts for the Authorization header, which is set right
+// before sending every request. The original client is left untouched.
+func (c *Client) WithTokenSource(ts TokenSource) *Client {
+	clone := c.copy()
+	clone.tokenSource = ts
 
-	return c
+	return clone
 }
 
 // A Client manages communication with the Lichess API.
@@ -63,11 +113,71 @@ type Client struct {
 	// set to a domain endpoint. BaseURL should always be specified with a trailing slash.
 	BaseURL *url.URL
 
+	// UserAgent used when communicating with the Lichess API.
+	// Defaults to "go-lichess", but Lichess asks API consumers to identify
+	// themselves, e.g. "my-bot/1.0 (contact@example.com)".
+	UserAgent string
+
+	// Header contains the headers sent with every request (e.g. for tracing).
+	// They don't replace the ones set by the client, like Accept.
+	Header http.Header
+
+	// Timeout limits the time of every request whose response is fully read by
+	// [Client.Do], including retries. It doesn't apply to streams, which are
+	// long-lived by design, and are limited by their context instead.
+	// No limit if <= 0, which is the default.
+	Timeout time.Duration
+
+	// MaxLineSize is the maximum size, in bytes, of a single line of
+	// an NDJSON response (e.g. a game exported with evals and clocks).
+	// Longer lines end the stream with [ErrLineTooLong]. No limit if <= 0,
+	// which is the default.
+	MaxLineSize int
+
+	// RetryPolicy used to retry idempotent requests on transient errors.
+	// Requests are never retried if nil, which is the default.
+	RetryPolicy *RetryPolicy
+
+	// ReconnectPolicy used to resume long-lived streams when the connection drops.
+	// Streams are never resumed if nil, which is the default.
+	ReconnectPolicy *ReconnectPolicy
+
+	// RateLimitPolicy specifies what to do with requests attempted while the
+	// rate limit cool-down is in effect. Defaults to [RateLimitFail].
+	RateLimitPolicy RateLimitPolicy
+
+	// Logger used to log requests (at debug level), retries and rate limits.
+	// Nothing is logged if nil, which is the default.
+	Logger *slog.Logger
+
+	// CheckScopes makes the client check, before sending any request, that the
+	// token has all the scopes required by the endpoint, and return a
+	// [*MissingScopeError] otherwise. The scopes of the token are fetched
+	// with [OAuthService.TestTokens] once, and then cached.
+	CheckScopes bool
+
+	tokenSource TokenSource // Source of the token used to authenticate requests, if any.
+
+	scopesMu   sync.Mutex            // Guards tokenInfos.
+	tokenInfos map[string]*TokenInfo // Cached information of the tokens, keyed by token.
+
+	rateLimiter *rateLimiter // Rate limit state, shared with the copies of the client.
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the Lichess API.
-	Games   *GamesService
-	Puzzles *PuzzlesService
+	Games       *GamesService
+	Puzzles     *PuzzlesService
+	Users       *UsersService
+	Account     *AccountService
+	OAuth       *OAuthService
+	Challenges  *ChallengesService
+	Board       *BoardService
+	Bot         *BotService
+	Tournaments *TournamentsService
+	Swiss       *SwissService
+	Teams       *TeamsService
+	Broadcasts  *BroadcastsService
 }
 
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
@@ -119,6 +229,16 @@ func (c *Client) newRequest(ctx context.Context, method, urlStr string, body io.
 		return nil, err
 	}
 
+	for key, values := range c.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
 	switch typeOfResponse(method, req.URL.Path) {
 	case jsonResponseType:
 		req.Header.Set("Accept", "application/json")
@@ -130,14 +250,44 @@ func (c *Client) newRequest(ctx context.Context, method, urlStr string, body io.
 }
 
 // BareDo sends an API request and lets you handle the api response. If an error
-// or API Error occurs, the error will contain more information. Otherwise, you
-// are supposed to read and close the response's Body. If rate limit is exceeded
-// and reset time is in the future, BareDo returns *RateLimitError immediately
-// without making a network API call.
+// or API Error occurs, the error will contain more information. Any response
+// with a non-2xx status code is reported as an [*ErrorResponse], or as a
+// [*RateLimitError] for HTTP 429. Otherwise, you are supposed to read and close
+// the response's Body. If rate limit is exceeded and reset time is in the future,
+// BareDo returns *RateLimitError immediately without making a network API call.
+// Similarly, if [Client.CheckScopes] is enabled and the token lacks any scope
+// required by the endpoint, BareDo returns *MissingScopeError. Idempotent
+// requests are retried according to [Client.RetryPolicy], if any.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is
 // canceled or times out, ctx.Err() will be returned.
 func (c *Client) BareDo(req *http.Request) (*Response, error) {
+	if c.CheckScopes {
+		if err := c.checkScopes(req); err != nil {
+			return nil, err
+		}
+	}
+
+	if policy := c.retryPolicyFor(req); policy != nil {
+		return c.doWithRetry(req, policy)
+	}
+
+	return c.bareDo(req)
+}
+
+// bareDo sends a single API request, without any retry.
+func (c *Client) bareDo(req *http.Request) (*Response, error) {
+	// If we've hit the rate limit, don't make further requests before the cool-down ends.
+	if err := c.checkRateLimitBeforeDo(req); err != nil {
+		return err.Response, err
+	}
+
+	if err := c.authorize(req); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -148,12 +298,32 @@ func (c *Client) BareDo(req *http.Request) (*Response, error) {
 		default:
 		}
 
+		// The error includes the URL of the request, which might hold tokens.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = redactURL(req.URL)
+		}
+
 		return nil, err
 	}
 
 	response := newResponse(resp)
+	response.maxLineSize = c.MaxLineSize
+
+	if c.Logger != nil {
+		c.Logger.DebugContext(req.Context(), "lichess: request sent",
+			"method", req.Method, "url", redactURL(req.URL),
+			"status", resp.StatusCode, "duration", time.Since(start))
+	}
+
+	c.updateRateLimit(response)
+	response.RateLimit = c.RateLimit()
 
-	return response, err
+	if err := checkResponse(response); err != nil {
+		return response, err
+	}
+
+	return response, nil
 }
 
 // Do sends an API request and returns the API response. The API response is
@@ -163,7 +333,15 @@ func (c *Client) BareDo(req *http.Request) (*Response, error) {
 // decode it. If v is nil, and no error happens, the response is returned as is.
 // If rate limit is exceeded and reset time is in the future, Do returns
 // *RateLimitError immediately without making a network API call.
+// Unless v is nil, the whole request is limited by [Client.Timeout], if any.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	if v != nil && c.Timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.Timeout)
+		defer cancel()
+
+		req = req.WithContext(ctx)
+	}
+
 	res, err := c.BareDo(req)
 	if err != nil {
 		return res, err
@@ -202,31 +380,41 @@ func (c *Client) decodeResponse(req *http.Request, res *Response, v interface{})
 				err = decErr
 			}
 		case ndJsonResponseType:
-			err = c.decodeNdJson(res, v)
+			err = c.decodeNdJson(req.Context(), res, v)
 		}
 	}
 
 	return err
 }
 
-func (c *Client) decodeNdJson(res *Response, v interface{}) error {
+// decodeNdJson decodes the NDJSON response into v, which must be a pointer to a slice.
+// It relies on [Stream] to read the response, so the only difference with [NewStream]
+// is that the item type is only known at runtime.
+func (c *Client) decodeNdJson(ctx context.Context, res *Response, v interface{}) error {
 	if reflect.ValueOf(v).Elem().Kind() != reflect.Slice {
 		return errors.New("v is not a pointer to a slice")
 	}
 
-	itemType := reflect.ValueOf(v).Elem().Type().Elem()
+	slice := reflect.ValueOf(v).Elem()
+	itemType := slice.Type().Elem()
 
-	scanner := bufio.NewScanner(res.Body)
-	for scanner.Scan() {
-		item := reflect.New(itemType).Interface()
-		if err := json.Unmarshal(scanner.Bytes(), item); err != nil {
+	stream := NewStream[json.RawMessage](ctx, res)
+	defer func() {
+		// Explicit ignore error.
+		// The body is also closed by Do.
+		_ = stream.Close()
+	}()
+
+	for stream.Next() {
+		item := reflect.New(itemType)
+		if err := json.Unmarshal(stream.Value(), item.Interface()); err != nil {
 			return err
 		}
 
-		reflect.ValueOf(v).Elem().Set(reflect.Append(reflect.ValueOf(v).Elem(), reflect.ValueOf(item).Elem()))
+		slice.Set(reflect.Append(slice, item.Elem()))
 	}
 
-	return nil
+	return stream.Err()
 }
 
 type service struct {
@@ -238,6 +426,12 @@ type service struct {
 // pagination links.
 type Response struct {
 	*http.Response
+
+	// RateLimit is the rate limit state of the client
+	// as of the moment the response was received.
+	RateLimit RateLimit
+
+	maxLineSize int // Client.MaxLineSize, as of the moment the response was received.
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -269,6 +463,55 @@ func addOptions(s string, opts interface{}) (string, error) {
 	return u.String(), nil
 }
 
+// redactURL returns u as a string, with the values of the query parameters
+// that hold OAuth tokens (i.e. those of [ChallengesService.StartClocks])
+// redacted, so they don't leak into logs and error messages.
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	query := u.Query()
+
+	var redacted bool
+	for _, key := range []string{"token1", "token2"} {
+		if query.Has(key) {
+			query.Set(key, "xxxxx")
+			redacted = true
+		}
+	}
+
+	if !redacted {
+		return u.String()
+	}
+
+	clone := *u
+	clone.RawQuery = query.Encode()
+
+	return clone.String()
+}
+
+// formBody returns a form-encoded request body with the parameters in opts.
+// opts must be a struct whose fields may contain "url" tags.
+func formBody(opts interface{}) (RequestBody, error) {
+	body := RequestBody{Type: "application/x-www-form-urlencoded"}
+
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		body.Bytes = strings.NewReader("")
+		return body, nil
+	}
+
+	qs, err := query.Values(opts)
+	if err != nil {
+		return body, err
+	}
+
+	body.Bytes = strings.NewReader(qs.Encode())
+
+	return body, nil
+}
+
 type responseType uint8
 
 const (
@@ -284,14 +527,17 @@ func typeOfResponse(_, path string) responseType {
 		return jsonResponseType
 	case strings.Contains(path, "api/games/user/"),
 		strings.Contains(path, "api/stream/games-by-users"),
-		strings.Contains(path, "api/puzzle/activity"):
+		strings.Contains(path, "api/puzzle/activity"),
+		strings.Contains(path, "api/stream/event"),
+		strings.Contains(path, "api/board/game/stream/"),
+		strings.Contains(path, "api/bot/game/stream/"),
+		strings.Contains(path, "api/tournament/") && strings.HasSuffix(path, "/results"),
+		strings.Contains(path, "api/tournament/") && strings.HasSuffix(path, "/games"),
+		strings.Contains(path, "api/swiss/") && strings.HasSuffix(path, "/results"),
+		strings.Contains(path, "api/swiss/") && strings.HasSuffix(path, "/games"),
+		strings.Contains(path, "api/team/") && strings.HasSuffix(path, "/users"),
+		strings.HasSuffix(path, "api/broadcast"),
+		strings.HasSuffix(path, "api/broadcast/my-rounds"):
 		return ndJsonResponseType
 	}
 }
-
-// roundTripperFunc creates a RoundTripper (transport).
-type roundTripperFunc func(*http.Request) (*http.Response, error)
-
-func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
-	return fn(r)
-}
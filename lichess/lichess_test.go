@@ -0,0 +1,30 @@
+package lichess_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+// setup returns a client that sends its requests to a test server,
+// along with the mux used to register the handlers of the test.
+func setup(t *testing.T) (*lichess.Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+
+	client := lichess.NewClient(nil)
+	client.BaseURL = baseURL
+
+	return client, mux
+}
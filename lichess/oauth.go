@@ -0,0 +1,203 @@
+package lichess
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// OAuthService handles the OAuth2 authorization code flow with PKCE,
+// used to obtain access tokens on behalf of Lichess users.
+//
+// Lichess API docs: https://lichess.org/api#tag/OAuth
+type OAuthService service
+
+// OAuthConfig describes an OAuth2 client application. Lichess doesn't require
+// applications to be registered, so ClientID can be any unique identifier.
+type OAuthConfig struct {
+	// ClientID identifies the application, e.g. "example.com".
+	ClientID string
+	// RedirectURL is the URL users are redirected to, once they authorize
+	// (or deny) the application, with the authorization code.
+	RedirectURL string
+	// Scopes the application asks for.
+	Scopes []Scope
+}
+
+// OAuthToken represents an access token obtained with the OAuth2 flow.
+// Use it with [Client.WithAuthToken] to make authenticated requests.
+type OAuthToken struct {
+	TokenType   string `json:"token_type,omitempty"`   //nolint:tagliatelle
+	AccessToken string `json:"access_token,omitempty"` //nolint:tagliatelle
+	ExpiresIn   int    `json:"expires_in,omitempty"`   //nolint:tagliatelle // In seconds.
+}
+
+// oauthVerifierSize is the number of random bytes of a PKCE code
+// verifier, which produce a 43 characters long verifier once encoded.
+const oauthVerifierSize = 32
+
+// NewOAuthVerifier returns a new, random, PKCE code verifier. It must be kept
+// by the application (e.g. in the user session) between the redirection to the
+// authorization URL and the exchange of the authorization code.
+func NewOAuthVerifier() string {
+	data := make([]byte, oauthVerifierSize)
+	if _, err := rand.Read(data); err != nil {
+		// crypto/rand.Read never fails in supported platforms.
+		panic(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// oauthChallenge returns the S256 PKCE code challenge of the given verifier.
+func oauthChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the URL users have to be redirected to, in order
+// to authorize the application. The state is sent back to the redirect URL
+// unchanged, and should be checked to prevent CSRF attacks. The verifier
+// must be the same later used with [OAuthService.Exchange].
+// Find more details at https://lichess.org/api#tag/OAuth/operation/oauth.
+func (s *OAuthService) AuthCodeURL(cfg *OAuthConfig, state, verifier string) string {
+	u := s.client.BaseURL.JoinPath("oauth")
+
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {cfg.ClientID},
+		"redirect_uri":          {cfg.RedirectURL},
+		"code_challenge_method": {"S256"},
+		"code_challenge":        {oauthChallenge(verifier)},
+		"state":                 {state},
+	}
+
+	if len(cfg.Scopes) > 0 {
+		params.Set("scope", joinScopes(cfg.Scopes, " "))
+	}
+
+	u.RawQuery = params.Encode()
+
+	return u.String()
+}
+
+// Exchange exchanges the authorization code, received at the redirect URL,
+// for an access token. The verifier must be the same used to build the
+// authorization URL with [OAuthService.AuthCodeURL].
+// Find more details at https://lichess.org/api#tag/OAuth/operation/apiToken.
+func (s *OAuthService) Exchange(
+	ctx context.Context,
+	cfg *OAuthConfig,
+	code, verifier string,
+) (*OAuthToken, *Response, error) {
+	u := "api/token"
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"code_verifier": {verifier},
+		"redirect_uri":  {cfg.RedirectURL},
+		"client_id":     {cfg.ClientID},
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, RequestBody{
+		Bytes: strings.NewReader(form.Encode()),
+		Type:  "application/x-www-form-urlencoded",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var token *OAuthToken
+	resp, err := s.client.Do(req, &token)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return token, resp, nil
+}
+
+// TokenInfo represents the information of an access token.
+type TokenInfo struct {
+	UserId  string  `json:"userId,omitempty"`
+	Scopes  []Scope `json:"-"`
+	Expires *int64  `json:"expires,omitempty"` // Nil if the token never expires.
+}
+
+// UnmarshalJSON decodes the comma-separated list of scopes sent by Lichess.
+func (t *TokenInfo) UnmarshalJSON(data []byte) error {
+	type tokenInfo TokenInfo
+
+	var aux struct {
+		tokenInfo
+		Scopes string `json:"scopes"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	*t = TokenInfo(aux.tokenInfo)
+	t.Scopes = nil
+
+	for _, scope := range strings.Split(aux.Scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			t.Scopes = append(t.Scopes, Scope(scope))
+		}
+	}
+
+	return nil
+}
+
+// HasScope reports whether the token has been granted the given scope.
+func (t *TokenInfo) HasScope(scope Scope) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TestTokens gets the information of up to 1000 access tokens, keyed by token.
+// Invalid, expired or revoked tokens are mapped to nil.
+// Find more details at https://lichess.org/api#tag/OAuth/operation/tokenTest.
+func (s *OAuthService) TestTokens(ctx context.Context, tokens []string) (map[string]*TokenInfo, *Response, error) {
+	u := "api/token/test"
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, RequestBody{
+		Bytes: strings.NewReader(strings.Join(tokens, ",")),
+		Type:  "text/plain",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var infos map[string]*TokenInfo
+	resp, err := s.client.Do(req, &infos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return infos, resp, nil
+}
+
+// RevokeToken revokes the access token used by the client to authenticate.
+// Find more details at https://lichess.org/api#tag/OAuth/operation/apiTokenDelete.
+func (s *OAuthService) RevokeToken(ctx context.Context) (*Response, error) {
+	u := "api/token"
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body, if any, is discarded.
+	return s.client.Do(req, io.Discard)
+}
@@ -0,0 +1,153 @@
+package lichess_test
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestNewOAuthVerifier(t *testing.T) {
+	t.Parallel()
+
+	verifier := lichess.NewOAuthVerifier()
+
+	// PKCE verifiers must be 43 to 128 characters long (RFC 7636, section 4.1).
+	assert.Len(t, verifier, 43)
+	_, err := base64.RawURLEncoding.DecodeString(verifier)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, verifier, lichess.NewOAuthVerifier())
+}
+
+func TestOAuthService_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	client, _ := setup(t)
+	cfg := &lichess.OAuthConfig{
+		ClientID:    "example.com",
+		RedirectURL: "https://example.com/callback",
+		Scopes:      []lichess.Scope{lichess.ScopeEmailRead, lichess.ScopeChallengeWrite},
+	}
+
+	// Test vector from RFC 7636, appendix B.
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+	authURL, err := url.Parse(client.OAuth.AuthCodeURL(cfg, "some-state", verifier))
+	require.NoError(t, err)
+
+	assert.Equal(t, client.BaseURL.String()+"oauth", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+	assert.Equal(t, url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"example.com"},
+		"redirect_uri":          {"https://example.com/callback"},
+		"code_challenge_method": {"S256"},
+		"code_challenge":        {"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"},
+		"scope":                 {"email:read challenge:write"},
+		"state":                 {"some-state"},
+	}, authURL.Query())
+}
+
+func TestOAuthService_Exchange(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"some-code"},
+			"code_verifier": {"some-verifier"},
+			"redirect_uri":  {"https://example.com/callback"},
+			"client_id":     {"example.com"},
+		}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{"token_type":"Bearer","access_token":"lio_token","expires_in":31536000}`))
+	})
+
+	cfg := &lichess.OAuthConfig{ClientID: "example.com", RedirectURL: "https://example.com/callback"}
+
+	token, _, err := client.OAuth.Exchange(context.Background(), cfg, "some-code", "some-verifier")
+	require.NoError(t, err)
+	assert.Equal(t, &lichess.OAuthToken{
+		TokenType:   "Bearer",
+		AccessToken: "lio_token",
+		ExpiresIn:   31536000,
+	}, token)
+}
+
+func TestOAuthService_Exchange_errorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/token", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"hash of code_verifier does not match"}`))
+	})
+
+	cfg := &lichess.OAuthConfig{ClientID: "example.com", RedirectURL: "https://example.com/callback"}
+
+	token, resp, err := client.OAuth.Exchange(context.Background(), cfg, "some-code", "wrong-verifier")
+	require.ErrorIs(t, err, lichess.ErrBadRequest)
+	assert.Nil(t, token)
+
+	var errResp *lichess.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusBadRequest, errResp.StatusCode)
+	assert.Same(t, resp, errResp.Response)
+}
+
+func TestOAuthService_RevokeToken(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("DELETE /api/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer lio_token", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.WithAuthToken("lio_token").OAuth.RevokeToken(context.Background())
+	require.NoError(t, err)
+}
+
+func TestOAuthService_TestTokens(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/token/test", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "lip_token,lip_revoked", string(body))
+
+		_, _ = w.Write([]byte(`{
+			"lip_token": {"userId": "chucknorris", "scopes": "email:read, puzzle:read", "expires": 1760000000000},
+			"lip_revoked": null
+		}`))
+	})
+
+	infos, _, err := client.OAuth.TestTokens(context.Background(), []string{"lip_token", "lip_revoked"})
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	info := infos["lip_token"]
+	require.NotNil(t, info)
+	assert.Equal(t, "chucknorris", info.UserId)
+	assert.Equal(t, []lichess.Scope{lichess.ScopeEmailRead, lichess.ScopePuzzleRead}, info.Scopes)
+	assert.True(t, info.HasScope(lichess.ScopePuzzleRead))
+	assert.False(t, info.HasScope(lichess.ScopePuzzleWrite))
+	require.NotNil(t, info.Expires)
+	assert.Equal(t, int64(1760000000000), *info.Expires)
+
+	assert.Contains(t, infos, "lip_revoked")
+	assert.Nil(t, infos["lip_revoked"])
+}
@@ -0,0 +1,133 @@
+package lichess
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ClientOption configures a [Client] created with [NewClientWithOptions].
+type ClientOption func(*Client) error
+
+// NewClientWithOptions returns a new Lichess API client, like [NewClient],
+// configured with the given options, which are applied in order. An error
+// is returned if any option is invalid (e.g. a relative base URL).
+func NewClientWithOptions(httpClient *http.Client, opts ...ClientOption) (*Client, error) {
+	c := NewClient(httpClient)
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// WithBaseURL sets the base URL for API requests, which must be an absolute
+// HTTP(S) URL. A trailing slash is added to its path, if missing.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("lichess: invalid base URL: %w", err)
+		}
+
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("lichess: invalid base URL %q: must be an absolute HTTP(S) URL", baseURL)
+		}
+
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+
+		c.BaseURL = u
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+// Lichess asks API consumers to identify themselves, e.g. "my-bot/1.0 (contact@example.com)".
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.Header == nil {
+			c.Header = make(http.Header)
+		}
+
+		c.Header.Add(key, value)
+
+		return nil
+	}
+}
+
+// WithAuthToken sets the token used for the Authorization header.
+// See [Client.WithAuthToken].
+func WithAuthToken(token string) ClientOption {
+	return WithTokenSource(staticTokenSource(token))
+}
+
+// WithTokenSource sets the source of the tokens used for the
+// Authorization header. See [Client.WithTokenSource].
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *Client) error {
+		c.tokenSource = ts
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the [Client.RetryPolicy].
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = policy
+		return nil
+	}
+}
+
+// WithReconnectPolicy sets the [Client.ReconnectPolicy].
+func WithReconnectPolicy(policy *ReconnectPolicy) ClientOption {
+	return func(c *Client) error {
+		c.ReconnectPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimitPolicy sets the [Client.RateLimitPolicy].
+func WithRateLimitPolicy(policy RateLimitPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RateLimitPolicy = policy
+		return nil
+	}
+}
+
+// WithTimeout sets the [Client.Timeout].
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("lichess: negative timeout %v", timeout)
+		}
+
+		c.Timeout = timeout
+
+		return nil
+	}
+}
+
+// WithLogger sets the [Client.Logger].
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
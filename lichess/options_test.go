@@ -0,0 +1,111 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /prefix/api/account", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-bot/1.0 (contact@example.com)", r.Header.Get("User-Agent"))
+		assert.Equal(t, "Bearer lip_token", r.Header.Get("Authorization"))
+		assert.Equal(t, []string{"1", "2"}, r.Header.Values("X-Trace"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(`{"id":"chucknorris"}`))
+	})
+
+	retryPolicy := &lichess.RetryPolicy{MaxAttempts: 3}
+	reconnectPolicy := &lichess.ReconnectPolicy{MaxAttempts: 3}
+
+	client, err := lichess.NewClientWithOptions(server.Client(),
+		// The trailing slash is added, if missing.
+		lichess.WithBaseURL(server.URL+"/prefix"),
+		lichess.WithUserAgent("my-bot/1.0 (contact@example.com)"),
+		lichess.WithAuthToken("lip_token"),
+		lichess.WithHeader("X-Trace", "1"),
+		lichess.WithHeader("X-Trace", "2"),
+		// The Accept header set by the client takes precedence.
+		lichess.WithHeader("Accept", "text/plain"),
+		lichess.WithTimeout(time.Minute),
+		lichess.WithRetryPolicy(retryPolicy),
+		lichess.WithReconnectPolicy(reconnectPolicy),
+		lichess.WithRateLimitPolicy(lichess.RateLimitWait),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, server.URL+"/prefix/", client.BaseURL.String())
+	assert.Equal(t, time.Minute, client.Timeout)
+	assert.Same(t, retryPolicy, client.RetryPolicy)
+	assert.Same(t, reconnectPolicy, client.ReconnectPolicy)
+	assert.Equal(t, lichess.RateLimitWait, client.RateLimitPolicy)
+
+	user, _, err := client.Account.GetAccount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "chucknorris", user.Id)
+}
+
+func TestNewClientWithOptions_noOptions(t *testing.T) {
+	t.Parallel()
+
+	client, err := lichess.NewClientWithOptions(nil)
+	require.NoError(t, err)
+
+	// Equivalent to NewClient(nil).
+	assert.Equal(t, lichess.NewClient(nil).BaseURL, client.BaseURL)
+	assert.Equal(t, "go-lichess", client.UserAgent)
+}
+
+func TestNewClientWithOptions_invalidOption(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]lichess.ClientOption{
+		"relative base URL":  lichess.WithBaseURL("lichess.org"),
+		"non-HTTP base URL":  lichess.WithBaseURL("ftp://lichess.org/"),
+		"malformed base URL": lichess.WithBaseURL("https://lichess.org/%zz"),
+		"negative timeout":   lichess.WithTimeout(-time.Second),
+	}
+
+	for name, opt := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := lichess.NewClientWithOptions(nil, lichess.WithUserAgent("my-bot/1.0"), opt)
+			require.Error(t, err)
+			assert.Nil(t, client)
+		})
+	}
+}
+
+func TestNewClientWithOptions_withTimeout(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		_, _ = w.Write([]byte(`{"id":"chucknorris"}`))
+	})
+
+	client, err := lichess.NewClientWithOptions(nil, lichess.WithBaseURL(client.BaseURL.String()),
+		lichess.WithTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+
+	_, _, err = client.Account.GetAccount(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
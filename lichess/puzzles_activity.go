@@ -2,9 +2,15 @@ package lichess
 
 import (
 	"context"
+	"fmt"
+	"iter"
 	"net/http"
 )
 
+// defaultPuzzleActivityPageSize is the page size used by
+// PuzzlesService.ActivityAll when no Max is specified.
+const defaultPuzzleActivityPageSize = 100
+
 // GetPuzzleActivityOptions specifies parameters for
 // PuzzlesService.GetPuzzleActivity method.
 type GetPuzzleActivityOptions struct {
@@ -39,3 +45,57 @@ func (s *PuzzlesService) GetPuzzleActivity(
 
 	return rounds, resp, nil
 }
+
+// ActivityAll returns an iterator over all the [PuzzleRound] of the authenticated user,
+// which transparently paginates [PuzzlesService.GetPuzzleActivity] backwards in time.
+// If specified, opts.Before is used as the starting point, and opts.Max as the page size
+// (defaults to 100, and must be positive). Each page is requested once the previous one has been consumed,
+// and any error is yielded as the last pair.
+func (s *PuzzlesService) ActivityAll(
+	ctx context.Context,
+	opts *GetPuzzleActivityOptions,
+) iter.Seq2[*PuzzleRound, error] {
+	return func(yield func(*PuzzleRound, error) bool) {
+		var page GetPuzzleActivityOptions
+		if opts != nil {
+			page = *opts
+		}
+
+		if page.Max == nil {
+			size := defaultPuzzleActivityPageSize
+			page.Max = &size
+		}
+
+		if *page.Max <= 0 {
+			yield(nil, fmt.Errorf("invalid page size: %d, must be positive", *page.Max))
+			return
+		}
+
+		for {
+			rounds, _, err := s.GetPuzzleActivity(ctx, &page)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, round := range rounds {
+				if !yield(round, nil) {
+					return
+				}
+			}
+
+			if len(rounds) == 0 || len(rounds) < *page.Max {
+				return
+			}
+
+			// Stop if the next page wouldn't move backwards,
+			// otherwise we'd be requesting the same page forever.
+			before := rounds[len(rounds)-1].Date
+			if page.Before != nil && before >= *page.Before {
+				return
+			}
+
+			page.Before = &before
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package lichess_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestPuzzlesService_ActivityAll(t *testing.T) {
+	t.Parallel()
+
+	// Rounds, from the newest to the oldest, with their date as id.
+	dates := []int{50, 40, 30, 20, 10}
+
+	var befores []string
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/puzzle/activity", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("max"))
+		befores = append(befores, r.URL.Query().Get("before"))
+
+		before := 100
+		if b := r.URL.Query().Get("before"); b != "" {
+			before, _ = strconv.Atoi(b)
+		}
+
+		var lines []string
+		for _, date := range dates {
+			if date < before && len(lines) < 2 {
+				lines = append(lines, fmt.Sprintf("{\"date\":%d,\"puzzle\":{\"id\":\"%d\"}}", date, date))
+			}
+		}
+
+		_, _ = w.Write([]byte(strings.Join(lines, "\n")))
+	})
+
+	pageSize := 2
+
+	var ids []string
+	for round, err := range client.Puzzles.ActivityAll(context.Background(),
+		&lichess.GetPuzzleActivityOptions{Max: &pageSize}) {
+		require.NoError(t, err)
+		ids = append(ids, round.Puzzle.Id)
+	}
+
+	assert.Equal(t, []string{"50", "40", "30", "20", "10"}, ids)
+	assert.Equal(t, []string{"", "40", "20"}, befores)
+}
+
+func TestPuzzlesService_ActivityAll_break(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/puzzle/activity", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "100", r.URL.Query().Get("max"))
+		_, _ = w.Write([]byte("{\"date\":2,\"puzzle\":{\"id\":\"p1\"}}\n{\"date\":1,\"puzzle\":{\"id\":\"p2\"}}\n"))
+	})
+
+	for round, err := range client.Puzzles.ActivityAll(context.Background(), nil) {
+		require.NoError(t, err)
+		assert.Equal(t, "p1", round.Puzzle.Id)
+
+		break
+	}
+
+	assert.Equal(t, 1, calls, "the next page must not be requested")
+}
+
+func TestPuzzlesService_ActivityAll_error(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/puzzle/activity", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	var lastErr error
+	for round, err := range client.Puzzles.ActivityAll(context.Background(), nil) {
+		assert.Nil(t, round)
+		lastErr = err
+	}
+
+	require.ErrorIs(t, lastErr, lichess.ErrUnauthorized)
+}
+
+func TestPuzzlesService_ActivityAll_empty(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/puzzle/activity", func(_ http.ResponseWriter, _ *http.Request) {
+		calls++
+	})
+
+	for round, err := range client.Puzzles.ActivityAll(context.Background(), nil) {
+		t.Fatalf("unexpected round: %v, %v", round, err)
+	}
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestPuzzlesService_ActivityAll_invalidMax(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]int{
+		"zero":     0,
+		"negative": -1,
+	}
+
+	for name, pageSize := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc("GET /api/puzzle/activity", func(_ http.ResponseWriter, _ *http.Request) {
+				t.Error("no page must be requested")
+			})
+
+			var lastErr error
+			for round, err := range client.Puzzles.ActivityAll(context.Background(),
+				&lichess.GetPuzzleActivityOptions{Max: &pageSize}) {
+				assert.Nil(t, round)
+				lastErr = err
+			}
+
+			require.Error(t, lastErr)
+		})
+	}
+}
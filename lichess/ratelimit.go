@@ -0,0 +1,175 @@
+package lichess
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimitCoolDown is the time Lichess asks API consumers to wait
+// before resuming requests, after receiving an HTTP 429 response.
+// Find more details at https://lichess.org/api#section/Introduction/Rate-limiting.
+const rateLimitCoolDown = time.Minute
+
+// RateLimit represents the rate limit state of a [Client].
+type RateLimit struct {
+	// Reset is the time at which the current cool-down ends.
+	// It is zero if no HTTP 429 response has been received yet.
+	Reset time.Time
+}
+
+// Limited reports whether the cool-down is still in effect.
+func (r RateLimit) Limited() bool {
+	return time.Now().Before(r.Reset)
+}
+
+// RateLimitPolicy specifies what a [Client] does with requests
+// attempted while the rate limit cool-down is in effect.
+type RateLimitPolicy int
+
+const (
+	// RateLimitFail makes requests fail immediately with a
+	// [*RateLimitError], without making a network API call.
+	RateLimitFail RateLimitPolicy = iota
+	// RateLimitWait makes requests wait until the cool-down ends (or their
+	// context is done), and then sends them.
+	RateLimitWait
+)
+
+// RateLimitError occurs when Lichess returns an HTTP 429 response, or when
+// a request is attempted while the rate limit cool-down is still in effect.
+type RateLimitError struct {
+	Response *Response // HTTP response that caused this error.
+	Reset    time.Time // Time at which the cool-down ends.
+	Message  string    // Error message returned by Lichess, if any.
+}
+
+func (r *RateLimitError) Error() string {
+	msg := r.Message
+	if msg == "" {
+		msg = "API rate limit exceeded"
+	}
+
+	wait := time.Until(r.Reset).Round(time.Second)
+
+	if r.Response == nil || r.Response.Request == nil {
+		return fmt.Sprintf("%d %s [rate reset in %v]", http.StatusTooManyRequests, msg, wait)
+	}
+
+	return fmt.Sprintf("%v %v: %d %s [rate reset in %v]",
+		r.Response.Request.Method, redactURL(r.Response.Request.URL), http.StatusTooManyRequests, msg, wait)
+}
+
+// rateLimiter tracks the rate limit state of a [Client], and its copies.
+type rateLimiter struct {
+	mu    sync.Mutex
+	state RateLimit // Rate limit state, as of the last HTTP 429 response.
+}
+
+func (r *rateLimiter) get() RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.state
+}
+
+func (r *rateLimiter) setReset(reset time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state.Reset = reset
+}
+
+// RateLimit returns the current rate limit state of the client,
+// which is shared with the copies made with [Client.WithAuthToken]
+// and [Client.WithTokenSource].
+func (c *Client) RateLimit() RateLimit {
+	return c.rateLimiter.get()
+}
+
+// checkRateLimitBeforeDo does not make any network calls, but uses the
+// existing rate limit state of the client to return an error early,
+// if the cool-down is still in effect. With [RateLimitWait], it waits
+// until the cool-down ends instead, unless the request context is done.
+func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
+	rate := c.RateLimit()
+	if !rate.Limited() {
+		return nil
+	}
+
+	if c.RateLimitPolicy == RateLimitWait {
+		if c.Logger != nil {
+			c.Logger.InfoContext(req.Context(), "lichess: waiting for the rate limit cool-down",
+				"method", req.Method, "url", redactURL(req.URL), "reset", rate.Reset)
+		}
+
+		if sleep(req.Context(), time.Until(rate.Reset)) {
+			return nil
+		}
+	}
+
+	// Create a fake response, so callers can
+	// handle it like any other HTTP 429 response.
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", http.StatusTooManyRequests, http.StatusText(http.StatusTooManyRequests)),
+		StatusCode: http.StatusTooManyRequests,
+		Request:    req,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+
+	response := newResponse(resp)
+	response.RateLimit = rate
+
+	return &RateLimitError{
+		Response: response,
+		Reset:    rate.Reset,
+		Message:  "API rate limit cool-down still in effect; not making remote request",
+	}
+}
+
+// updateRateLimit records a new cool-down if the response is an HTTP 429.
+func (c *Client) updateRateLimit(r *Response) {
+	if r.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	wait := rateLimitCoolDown
+	if retryAfter, ok := parseRetryAfter(r.Header); ok && retryAfter > wait {
+		wait = retryAfter
+	}
+
+	if c.Logger != nil {
+		c.Logger.WarnContext(r.Request.Context(), "lichess: rate limit exceeded",
+			"method", r.Request.Method, "url", redactURL(r.Request.URL), "coolDown", wait)
+	}
+
+	c.rateLimiter.setReset(time.Now().Add(wait))
+}
+
+// parseRetryAfter parses the Retry-After header, which
+// can be either a number of seconds or an HTTP date.
+func parseRetryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
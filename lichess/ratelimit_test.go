@@ -0,0 +1,95 @@
+package lichess_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+
+	var calls atomic.Int32
+	mux.HandleFunc("GET /game/export/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error":"Too many requests. Try again later."}`))
+	})
+
+	assert.False(t, client.RateLimit().Limited())
+
+	before := time.Now()
+	_, resp, err := client.Games.ExportById(context.Background(), "abcdefgh", nil)
+
+	var rateErr *lichess.RateLimitError
+	require.ErrorAs(t, err, &rateErr)
+	assert.Equal(t, "Too many requests. Try again later.", rateErr.Message)
+	assert.WithinRange(t, rateErr.Reset, before.Add(time.Minute), time.Now().Add(time.Minute))
+	assert.Equal(t, rateErr.Reset, resp.RateLimit.Reset)
+	assert.True(t, client.RateLimit().Limited())
+
+	var errResp *lichess.ErrorResponse
+	assert.False(t, errors.As(err, &errResp))
+
+	// Requests are short-circuited until the cool-down ends.
+	_, resp, err = client.Games.ExportById(context.Background(), "abcdefgh", nil)
+	require.ErrorAs(t, err, &rateErr)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Contains(t, rateErr.Message, "not making remote request")
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRateLimit_retryAfter(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /game/export/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	before := time.Now()
+	_, _, err := client.Games.ExportById(context.Background(), "abcdefgh", nil)
+
+	var rateErr *lichess.RateLimitError
+	require.ErrorAs(t, err, &rateErr)
+	assert.WithinRange(t, rateErr.Reset, before.Add(2*time.Minute), time.Now().Add(2*time.Minute))
+}
+
+func TestRateLimit_wait(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	client.RateLimitPolicy = lichess.RateLimitWait
+
+	var calls atomic.Int32
+	mux.HandleFunc("GET /game/export/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, _, err := client.Games.ExportById(context.Background(), "abcdefgh", nil)
+	require.Error(t, err)
+
+	// The request waits for the cool-down, which outlasts the context.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err = client.Games.ExportById(ctx, "abcdefgh", nil)
+
+	var rateErr *lichess.RateLimitError
+	require.ErrorAs(t, err, &rateErr)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+}
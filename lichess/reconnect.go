@@ -0,0 +1,162 @@
+package lichess
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ReconnectPolicy specifies how long-lived streams reconnect, transparently,
+// when the connection drops. Reconnection is opt-in, see [Client.ReconnectPolicy].
+// It's used by [GamesService.StreamGameMoves], [GamesService.StreamGamesOfUsers],
+// and the streams of the Board API and the Bot API.
+type ReconnectPolicy struct {
+	// MaxAttempts is the maximum number of consecutive reconnection attempts,
+	// before giving up and reporting the error. No limit if <= 0.
+	MaxAttempts int
+	// MinBackoff is the wait before the first reconnection attempt, which is
+	// doubled after every consecutive attempt, with some random jitter.
+	// Defaults to 1s.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum wait between two attempts. Defaults to 30s.
+	// It doesn't apply to the rate limit cool-down, which is always honored.
+	MaxBackoff time.Duration
+	// OnReconnect, if not nil, is called before every reconnection attempt,
+	// with the number of consecutive attempts (starting at 1) and the error
+	// that broke the stream, or made the previous attempt fail.
+	OnReconnect func(attempt int, err error)
+}
+
+// errStreamInterrupted is reported when a stream
+// ends before reaching its expected end.
+var errStreamInterrupted = errors.New("lichess: stream interrupted")
+
+// reconnectWait returns how long to wait before the given reconnection
+// attempt, after the given error, and whether it's worth reconnecting.
+func (p *ReconnectPolicy) reconnectWait(attempt int, err error) (time.Duration, bool) {
+	if p.MaxAttempts > 0 && attempt > p.MaxAttempts {
+		return 0, false
+	}
+
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return time.Until(rateErr.Reset), true
+	}
+
+	// Client errors (e.g. the game doesn't exist), malformed lines and lines
+	// too long won't be solved by reconnecting, because they would be sent again.
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.StatusCode < http.StatusInternalServerError {
+		return 0, false
+	}
+
+	var decodeErr *decodeError
+	if errors.As(err, &decodeErr) || errors.Is(err, ErrLineTooLong) {
+		return 0, false
+	}
+
+	return backoff(attempt, p.MinBackoff, p.MaxBackoff), true
+}
+
+// resumableStream describes a long-lived stream that can be resumed
+// by opening a new one, according to a [ReconnectPolicy].
+type resumableStream[T any] struct {
+	// open opens a new stream.
+	open func() (*Stream[T], error)
+	// done reports whether the value is the last one of the stream, after
+	// which the stream ends normally. Any other end is considered an interruption.
+	done func(T) bool
+	// keep reports whether the value has to be yielded, so
+	// values already seen before reconnecting can be skipped.
+	keep func(T) bool
+	// replayed, if not nil, reports whether a kept value is sent again on every
+	// connection (e.g. the state of the game), so it isn't progress: the count of
+	// reconnection attempts is only reset once a new value is kept. Otherwise, a
+	// stream that breaks the same way on every connection would never give up.
+	replayed func(T) bool
+	// resumed, if not nil, is called every time the stream is
+	// resumed, before any value of the new stream is yielded.
+	resumed func()
+}
+
+// run yields the values of the given stream, reconnecting whenever it's interrupted,
+// until the stream ends normally, ctx is done or yield returns false, in which cases
+// it returns nil. Otherwise, it returns the error that made the policy give up.
+func (r resumableStream[T]) run(
+	ctx context.Context,
+	policy *ReconnectPolicy,
+	stream *Stream[T],
+	yield func(T) bool,
+) error {
+	var err error
+
+	for attempt := 0; ; {
+		if stream != nil {
+			for stream.Next() {
+				value := stream.Value()
+
+				keep := r.keep(value)
+				if keep && (r.replayed == nil || !r.replayed(value)) {
+					attempt = 0
+				}
+
+				if (keep && !yield(value)) || r.done(value) {
+					// Explicit ignore error.
+					// We might want to revisit this later.
+					_ = stream.Close()
+					return nil
+				}
+			}
+
+			if err = stream.Err(); err == nil {
+				err = errStreamInterrupted
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		attempt++
+
+		wait, ok := policy.reconnectWait(attempt, err)
+		if !ok {
+			return err
+		}
+
+		if policy.OnReconnect != nil {
+			policy.OnReconnect(attempt, err)
+		}
+
+		if !sleep(ctx, wait) {
+			return nil
+		}
+
+		stream, err = r.open()
+		if err == nil && r.resumed != nil {
+			r.resumed()
+		}
+	}
+}
+
+// openStream sends the request built by newReq and returns
+// a [Stream] that decodes the response with decode.
+func openStream[T any](
+	ctx context.Context,
+	c *Client,
+	newReq func() (*http.Request, error),
+	decode func([]byte) (T, error),
+) (*Stream[T], error) {
+	req, err := newReq()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStream(ctx, resp, decode), nil
+}
@@ -0,0 +1,315 @@
+package lichess_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+const (
+	startFen = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+	e4Fen    = "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1"
+	e5Fen    = "rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2"
+)
+
+// setupReconnect returns a client with a [lichess.ReconnectPolicy] with short backoffs,
+// which records the reconnection attempts, along with the mux used by the test server.
+func setupReconnect(t *testing.T, maxAttempts int) (*lichess.Client, *http.ServeMux, *[]int) {
+	t.Helper()
+
+	var attempts []int
+
+	client, mux := setup(t)
+	client.ReconnectPolicy = &lichess.ReconnectPolicy{
+		MaxAttempts: maxAttempts,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+		OnReconnect: func(attempt int, err error) {
+			assert.Error(t, err)
+			attempts = append(attempts, attempt)
+		},
+	}
+
+	return client, mux, &attempts
+}
+
+func TestReconnect_StreamGameMoves(t *testing.T) {
+	t.Parallel()
+
+	client, mux, attempts := setupReconnect(t, 3)
+
+	var calls atomic.Int32
+	mux.HandleFunc("GET /api/stream/game/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			// The connection drops after the first move.
+			_, _ = w.Write([]byte(`{"id":"abcdefgh","fen":"` + startFen + `","turns":0}
+{"fen":"` + e4Fen + `","lm":"e2e4"}
+`))
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			// The current position is sent again after the description.
+			_, _ = w.Write([]byte(`{"id":"abcdefgh","fen":"` + e4Fen + `","turns":1}
+{"fen":"` + e4Fen + `","lm":"e2e4"}
+{"fen":"` + e5Fen + `","lm":"e7e5"}
+{"id":"abcdefgh","fen":"` + e5Fen + `","turns":2,"status":{"id":31,"name":"resign"},"winner":"white"}
+`))
+		}
+	})
+
+	events, _, err := client.Games.StreamGameMoves(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+
+	var got []string
+	for event := range events {
+		switch e := event.(type) {
+		case lichess.GameDescription:
+			got = append(got, "description")
+		case lichess.GameMove:
+			got = append(got, e.LM)
+		case lichess.GameFinished:
+			got = append(got, "finished")
+		default:
+			t.Fatalf("unexpected event: %#v", event)
+		}
+	}
+
+	assert.Equal(t, []string{"description", "e2e4", "description", "e7e5", "finished"}, got)
+	assert.Equal(t, []int{1, 2}, *attempts)
+	assert.Equal(t, int32(3), calls.Load(), "the stream must not be resumed once the game is finished")
+}
+
+func TestReconnect_StreamGameMoves_giveUp(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		status       int
+		wantAttempts []int
+	}{
+		"client error": {
+			status:       http.StatusNotFound,
+			wantAttempts: []int{1},
+		},
+		"max attempts": {
+			status:       http.StatusBadGateway,
+			wantAttempts: []int{1, 2},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, attempts := setupReconnect(t, 2)
+
+			var calls atomic.Int32
+			mux.HandleFunc("GET /api/stream/game/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+				if calls.Add(1) == 1 {
+					_, _ = w.Write([]byte(`{"id":"abcdefgh","fen":"` + startFen + `","turns":0}` + "\n"))
+					return
+				}
+				w.WriteHeader(tc.status)
+			})
+
+			events, _, err := client.Games.StreamGameMoves(context.Background(), "abcdefgh")
+			require.NoError(t, err)
+
+			var last lichess.GameStreamEvent
+			for event := range events {
+				last = event
+			}
+
+			streamErr, ok := last.(lichess.GameStreamEventError)
+			require.True(t, ok, "got %T, want GameStreamEventError", last)
+
+			var errResp *lichess.ErrorResponse
+			require.ErrorAs(t, streamErr, &errResp)
+			assert.Equal(t, tc.status, errResp.StatusCode)
+			assert.Equal(t, tc.wantAttempts, *attempts)
+		})
+	}
+}
+
+func TestReconnect_StreamGameMoves_sameBreak(t *testing.T) {
+	t.Parallel()
+
+	client, mux, attempts := setupReconnect(t, 3)
+
+	var calls atomic.Int32
+	mux.HandleFunc("GET /api/stream/game/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		// The connection drops in the middle of the first move, on every connection.
+		_, _ = w.Write([]byte(`{"id":"abcdefgh","fen":"` + startFen + `","turns":0}
+{"fen":"` + e4Fen))
+	})
+
+	events, _, err := client.Games.StreamGameMoves(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+
+	var (
+		descriptions int
+		last         lichess.GameStreamEvent
+	)
+	for event := range events {
+		if _, ok := event.(lichess.GameDescription); ok {
+			descriptions++
+		}
+		last = event
+	}
+
+	streamErr, ok := last.(lichess.GameStreamEventError)
+	require.True(t, ok, "got %T, want GameStreamEventError", last)
+	require.ErrorIs(t, streamErr, io.ErrUnexpectedEOF)
+
+	// The game description sent again on every connection
+	// doesn't reset the count of reconnection attempts.
+	assert.Equal(t, []int{1, 2, 3}, *attempts)
+	assert.Equal(t, int32(4), calls.Load())
+	assert.Equal(t, 4, descriptions)
+}
+
+func TestReconnect_StreamGameMoves_malformedLine(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		line        string
+		maxLineSize int
+		wantErr     func(t *testing.T, err error)
+	}{
+		"syntax error": {
+			line: `{"fen":}`,
+			wantErr: func(t *testing.T, err error) {
+				t.Helper()
+
+				var syntaxErr *json.SyntaxError
+				require.ErrorAs(t, err, &syntaxErr)
+			},
+		},
+		"line too long": {
+			line:        `{"fen":"` + e4Fen + `","lm":"e2e4","pad":"` + strings.Repeat("x", 128) + `"}`,
+			maxLineSize: len(startFen) + 64,
+			wantErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, lichess.ErrLineTooLong)
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, attempts := setupReconnect(t, 2)
+			client.MaxLineSize = tc.maxLineSize
+
+			var calls atomic.Int32
+			mux.HandleFunc("GET /api/stream/game/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				_, _ = w.Write([]byte(`{"id":"abcdefgh","fen":"` + startFen + `","turns":0}` + "\n" + tc.line + "\n"))
+			})
+
+			events, _, err := client.Games.StreamGameMoves(context.Background(), "abcdefgh")
+			require.NoError(t, err)
+
+			var last lichess.GameStreamEvent
+			for event := range events {
+				last = event
+			}
+
+			streamErr, ok := last.(lichess.GameStreamEventError)
+			require.True(t, ok, "got %T, want GameStreamEventError", last)
+			tc.wantErr(t, streamErr)
+
+			// The same line would be sent again, so there's no point in reconnecting.
+			assert.Empty(t, *attempts)
+			assert.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+func TestReconnect_BoardStreamGame_sameBreak(t *testing.T) {
+	t.Parallel()
+
+	client, mux, attempts := setupReconnect(t, 2)
+
+	var calls atomic.Int32
+	mux.HandleFunc("GET /api/board/game/stream/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{"type":"gameFull","id":"abcdefgh","state":{"moves":"","status":"started"}}` + "\n"))
+	})
+
+	events, _, err := client.Board.StreamGame(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+
+	var last lichess.GameStateEvent
+	for event := range events {
+		last = event
+	}
+
+	_, ok := last.(lichess.GameStateEventError)
+	require.True(t, ok, "got %T, want GameStateEventError", last)
+
+	// The full state of the game sent again on every connection
+	// doesn't reset the count of reconnection attempts.
+	assert.Equal(t, []int{1, 2}, *attempts)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestReconnect_StreamGamesOfUsers(t *testing.T) {
+	t.Parallel()
+
+	client, mux, attempts := setupReconnect(t, 2)
+
+	var calls atomic.Int32
+	mux.HandleFunc("POST /api/stream/games-by-users", func(w http.ResponseWriter, _ *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			_, _ = w.Write([]byte(`{"id":"game1","statusName":"started"}
+{"id":"game3","statusName":"started"}
+`))
+		case 2:
+			// Current games are sent again on every connection,
+			// but game3 finished while disconnected.
+			_, _ = w.Write([]byte(`{"id":"game1","statusName":"started"}
+{"id":"game2","statusName":"started"}
+{"id":"game1","statusName":"mate"}
+`))
+		case 3:
+			// Since game3 wasn't sent on the previous connection, it was
+			// forgotten, so it's yielded again if Lichess ever sends it.
+			_, _ = w.Write([]byte(`{"id":"game2","statusName":"started"}
+{"id":"game3","statusName":"started"}
+`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+
+	games, errs, _, err := client.Games.StreamGamesOfUsers(context.Background(), []string{"alice", "bob"}, nil)
+	require.NoError(t, err)
+
+	var got []string
+	for game := range games {
+		got = append(got, game.Id+"/"+string(game.StatusName))
+	}
+
+	assert.Equal(t, []string{
+		"game1/started", "game3/started",
+		"game2/started", "game1/mate",
+		"game3/started",
+	}, got)
+	require.ErrorIs(t, <-errs, lichess.ErrForbidden)
+	// Attempts are counted again once the stream is resumed.
+	assert.Equal(t, []int{1, 1, 1}, *attempts)
+}
@@ -0,0 +1,160 @@
+package lichess
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff = time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy specifies how a [Client] retries idempotent (GET and HEAD)
+// requests that failed because of a transient error: a connection error,
+// an HTTP 5xx response or an HTTP 429 response. Retries are opt-in,
+// see [Client.RetryPolicy], and can be disabled per call with [WithoutRetry].
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Values lower than 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry, which is doubled
+	// after every attempt, with some random jitter. Defaults to 1s.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum wait between two attempts. Defaults to 30s.
+	// It doesn't apply to the rate limit cool-down, nor to the Retry-After
+	// header, which are always honored.
+	MaxBackoff time.Duration
+}
+
+// backoff returns the wait before the given retry attempt (starting at 1).
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	return backoff(attempt, p.MinBackoff, p.MaxBackoff)
+}
+
+// backoff returns the wait before the given attempt (starting at 1), using
+// exponential backoff with jitter, with the defaults of [RetryPolicy].
+func backoff(attempt int, minBackoff, maxBackoff time.Duration) time.Duration {
+	if minBackoff <= 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	wait := minBackoff
+	for i := 1; i < attempt && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+
+	// Equal jitter: keep half of the wait, and randomize the other half.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec // No need for a secure random source.
+}
+
+// sleep waits for the given duration, or until ctx is done,
+// in which case it returns false.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// retryWait returns how long to wait before retrying a request that
+// failed with the given response and error, and whether it can be retried.
+func (p *RetryPolicy) retryWait(attempt int, resp *Response, err error) (time.Duration, bool) {
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return time.Until(rateErr.Reset), true
+	}
+
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		switch errResp.StatusCode {
+		case http.StatusInternalServerError, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		default:
+			return 0, false
+		}
+
+		if retryAfter, ok := parseRetryAfter(resp.Header); ok {
+			return retryAfter, true
+		}
+	}
+
+	// Any other error is a transport error (e.g. connection reset),
+	// because context errors are handled before calling retryWait.
+	return p.backoff(attempt), true
+}
+
+type retryDisabledKey struct{}
+
+// WithoutRetry returns a copy of ctx that disables the [Client.RetryPolicy]
+// for any request made with it.
+func WithoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryDisabledKey{}, true)
+}
+
+// retryPolicyFor returns the retry policy that applies to req,
+// or nil if req must not be retried.
+func (c *Client) retryPolicyFor(req *http.Request) *RetryPolicy {
+	if c.RetryPolicy == nil || c.RetryPolicy.MaxAttempts < 2 {
+		return nil
+	}
+
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return nil
+	}
+
+	if disabled, _ := req.Context().Value(retryDisabledKey{}).(bool); disabled {
+		return nil
+	}
+
+	return c.RetryPolicy
+}
+
+// doWithRetry sends the request with bareDo, retrying
+// it according to the given policy, if needed.
+func (c *Client) doWithRetry(req *http.Request, policy *RetryPolicy) (*Response, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.bareDo(req)
+		if err == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		wait, ok := policy.retryWait(attempt, resp, err)
+		if !ok {
+			return resp, err
+		}
+
+		if c.Logger != nil {
+			c.Logger.InfoContext(ctx, "lichess: retrying request",
+				"method", req.Method, "url", redactURL(req.URL),
+				"attempt", attempt+1, "wait", wait, "error", err)
+		}
+
+		if resp != nil {
+			// Explicit ignore error.
+			// Error responses are already read into memory.
+			_ = resp.Body.Close()
+		}
+
+		if !sleep(ctx, wait) {
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package lichess_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+// setupRetry returns a client with a fast retry policy, whose test server
+// replies to api/test with the given handler, counting the calls.
+func setupRetry(
+	t *testing.T,
+	maxAttempts int,
+	handler func(w http.ResponseWriter, r *http.Request, call int32),
+) (*lichess.Client, *atomic.Int32) {
+	t.Helper()
+
+	client, mux := setup(t)
+	client.RetryPolicy = &lichess.RetryPolicy{
+		MaxAttempts: maxAttempts,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+
+	var calls atomic.Int32
+	mux.HandleFunc("/api/test", func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r, calls.Add(1))
+	})
+
+	return client, &calls
+}
+
+func doTest(ctx context.Context, t *testing.T, client *lichess.Client, method string) (*lichess.Response, error) {
+	t.Helper()
+
+	req, err := client.NewRequest(ctx, method, "api/test")
+	require.NoError(t, err)
+
+	return client.Do(req, io.Discard)
+}
+
+func TestRetry_serverError(t *testing.T) {
+	t.Parallel()
+
+	client, calls := setupRetry(t, 3, func(w http.ResponseWriter, _ *http.Request, call int32) {
+		if call < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	resp, err := doTest(context.Background(), t, client, http.MethodGet)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetry_connectionError(t *testing.T) {
+	t.Parallel()
+
+	client, calls := setupRetry(t, 2, func(w http.ResponseWriter, _ *http.Request, call int32) {
+		if call == 1 {
+			// Close the connection without replying.
+			conn, _, err := http.NewResponseController(w).Hijack()
+			if assert.NoError(t, err) {
+				_ = conn.Close()
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	_, err := doTest(context.Background(), t, client, http.MethodGet)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetry_notRetried(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		method string
+		status int
+	}{
+		"post":            {method: http.MethodPost, status: http.StatusServiceUnavailable},
+		"client error":    {method: http.MethodGet, status: http.StatusNotFound},
+		"not implemented": {method: http.MethodGet, status: http.StatusNotImplemented},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, calls := setupRetry(t, 3, func(w http.ResponseWriter, _ *http.Request, _ int32) {
+				w.WriteHeader(tc.status)
+			})
+
+			_, err := doTest(context.Background(), t, client, tc.method)
+
+			var errResp *lichess.ErrorResponse
+			require.ErrorAs(t, err, &errResp)
+			assert.Equal(t, tc.status, errResp.StatusCode)
+			assert.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+func TestRetry_maxAttempts(t *testing.T) {
+	t.Parallel()
+
+	client, calls := setupRetry(t, 4, func(w http.ResponseWriter, _ *http.Request, _ int32) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := doTest(context.Background(), t, client, http.MethodGet)
+
+	var errResp *lichess.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusInternalServerError, errResp.StatusCode)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func TestRetry_withoutRetry(t *testing.T) {
+	t.Parallel()
+
+	client, calls := setupRetry(t, 3, func(w http.ResponseWriter, _ *http.Request, _ int32) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := doTest(lichess.WithoutRetry(context.Background()), t, client, http.MethodGet)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetry_retryAfter(t *testing.T) {
+	t.Parallel()
+
+	client, calls := setupRetry(t, 2, func(w http.ResponseWriter, _ *http.Request, call int32) {
+		if call == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	start := time.Now()
+	_, err := doTest(context.Background(), t, client, http.MethodGet)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+	// Retry-After takes precedence over the (much shorter) backoff.
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRetry_rateLimitCoolDown(t *testing.T) {
+	t.Parallel()
+
+	client, calls := setupRetry(t, 3, func(w http.ResponseWriter, _ *http.Request, _ int32) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	// The retry waits for the one-minute cool-down, which outlasts the context.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	_, err := doTest(ctx, t, client, http.MethodGet)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.True(t, client.RateLimit().Limited())
+}
@@ -0,0 +1,168 @@
+package lichess
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Scope represents a Lichess OAuth scope, which grants access to
+// some of the endpoints that require authentication.
+// Find more details at https://lichess.org/api#section/Introduction/Authentication.
+type Scope string
+
+const (
+	ScopePreferenceRead  Scope = "preference:read"
+	ScopePreferenceWrite Scope = "preference:write"
+	ScopeEmailRead       Scope = "email:read"
+	ScopeEngineRead      Scope = "engine:read"
+	ScopeEngineWrite     Scope = "engine:write"
+	ScopeChallengeRead   Scope = "challenge:read"
+	ScopeChallengeWrite  Scope = "challenge:write"
+	ScopeChallengeBulk   Scope = "challenge:bulk"
+	ScopeStudyRead       Scope = "study:read"
+	ScopeStudyWrite      Scope = "study:write"
+	ScopeTournamentWrite Scope = "tournament:write"
+	ScopeRacerWrite      Scope = "racer:write"
+	ScopePuzzleRead      Scope = "puzzle:read"
+	ScopePuzzleWrite     Scope = "puzzle:write"
+	ScopeTeamRead        Scope = "team:read"
+	ScopeTeamWrite       Scope = "team:write"
+	ScopeTeamLead        Scope = "team:lead"
+	ScopeFollowRead      Scope = "follow:read"
+	ScopeFollowWrite     Scope = "follow:write"
+	ScopeMsgWrite        Scope = "msg:write"
+	ScopeBoardPlay       Scope = "board:play"
+	ScopeBotPlay         Scope = "bot:play"
+	ScopeWebLogin        Scope = "web:login"
+	ScopeWebMod          Scope = "web:mod"
+)
+
+// MissingScopeError occurs when [Client.CheckScopes] is enabled, and the token
+// used by the client lacks any of the scopes required by the requested endpoint.
+// No network call is made to the endpoint in such case.
+type MissingScopeError struct {
+	Request  *http.Request // Request that wasn't sent.
+	Required []Scope       // Scopes required by the endpoint.
+	Missing  []Scope       // Scopes required by the endpoint, but not granted to the token.
+}
+
+func (e *MissingScopeError) Error() string {
+	return fmt.Sprintf("%v %v: token lacks the scopes required by the endpoint: %v",
+		e.Request.Method, redactURL(e.Request.URL), joinScopes(e.Missing, ", "))
+}
+
+// requiredScopes returns the scopes required by the endpoint,
+// determined by HTTP method and Request.URL.Path. Endpoints that
+// accept any of several scopes (e.g. accepting a challenge requires
+// challenge:write, board:play or bot:play) are not checked.
+func requiredScopes(method, path string) []Scope {
+	switch {
+	default:
+		return nil
+	case strings.HasSuffix(path, "api/puzzle/activity"):
+		return []Scope{ScopePuzzleRead}
+	case strings.HasSuffix(path, "api/account/email"):
+		return []Scope{ScopeEmailRead}
+	case strings.HasSuffix(path, "api/account/preferences"):
+		return []Scope{ScopePreferenceRead}
+	case strings.HasSuffix(path, "api/account/kid"):
+		if method == http.MethodPost {
+			return []Scope{ScopePreferenceWrite}
+		}
+		return []Scope{ScopePreferenceRead}
+	case method == http.MethodGet && strings.HasSuffix(path, "api/challenge"):
+		return []Scope{ScopeChallengeRead}
+	case strings.Contains(path, "api/board/"):
+		return []Scope{ScopeBoardPlay}
+	case strings.Contains(path, "api/bot/"):
+		return []Scope{ScopeBotPlay}
+	case method == http.MethodPost && strings.Contains(path, "api/tournament"),
+		method == http.MethodPost && strings.Contains(path, "api/swiss"):
+		return []Scope{ScopeTournamentWrite}
+	case method == http.MethodGet && strings.Contains(path, "api/team/") && strings.HasSuffix(path, "/requests"):
+		return []Scope{ScopeTeamRead}
+	case method == http.MethodPost && strings.Contains(path, "team/") &&
+		(strings.HasSuffix(path, "/join") || strings.HasSuffix(path, "/quit")):
+		return []Scope{ScopeTeamWrite}
+	case method == http.MethodPost && strings.Contains(path, "team/"):
+		return []Scope{ScopeTeamLead}
+	case method == http.MethodGet && strings.HasSuffix(path, "api/broadcast/my-rounds"):
+		return []Scope{ScopeStudyRead}
+	case method == http.MethodPost && strings.Contains(path, "broadcast/"):
+		return []Scope{ScopeStudyWrite}
+	}
+}
+
+// checkScopes checks that the token used by the client has all the scopes
+// required by the endpoint of the request. The scopes of the token are
+// fetched with [OAuthService.TestTokens] once, and then cached.
+// It doesn't fail if the client has no token, or the token is invalid,
+// so Lichess can reply as usual.
+func (c *Client) checkScopes(req *http.Request) error {
+	required := requiredScopes(req.Method, req.URL.Path)
+	if len(required) == 0 {
+		return nil
+	}
+
+	token, err := c.token(req)
+	if err != nil || token == "" {
+		return err
+	}
+
+	info, err := c.tokenInfo(req, token)
+	if err != nil || info == nil {
+		return err
+	}
+
+	var missing []Scope
+	for _, scope := range required {
+		if !info.HasScope(scope) {
+			missing = append(missing, scope)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &MissingScopeError{Request: req, Required: required, Missing: missing}
+	}
+
+	return nil
+}
+
+// tokenInfo returns the cached information of the token,
+// or fetches it with the context of the given request.
+func (c *Client) tokenInfo(req *http.Request, token string) (*TokenInfo, error) {
+	c.scopesMu.Lock()
+	info, ok := c.tokenInfos[token]
+	c.scopesMu.Unlock()
+
+	if ok {
+		return info, nil
+	}
+
+	infos, _, err := c.OAuth.TestTokens(req.Context(), []string{token})
+	if err != nil {
+		return nil, err
+	}
+
+	info = infos[token]
+
+	c.scopesMu.Lock()
+	defer c.scopesMu.Unlock()
+
+	if c.tokenInfos == nil {
+		c.tokenInfos = make(map[string]*TokenInfo)
+	}
+	c.tokenInfos[token] = info
+
+	return info, nil
+}
+
+func joinScopes(scopes []Scope, sep string) string {
+	strs := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		strs = append(strs, string(scope))
+	}
+
+	return strings.Join(strs, sep)
+}
@@ -0,0 +1,128 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+// setupScopes returns a client with [lichess.Client.CheckScopes] enabled, whose tokens
+// are described by the given api/token/test response, along with the mux used by the
+// test server and the number of calls to api/token/test.
+func setupScopes(t *testing.T, tokenInfos string) (*lichess.Client, *http.ServeMux, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	client, mux := setup(t)
+	client.CheckScopes = true
+
+	mux.HandleFunc("POST /api/token/test", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(tokenInfos))
+	})
+
+	return client, mux, &calls
+}
+
+func TestCheckScopes_missing(t *testing.T) {
+	t.Parallel()
+
+	client, mux, calls := setupScopes(t, `{"lip_token":{"userId":"chucknorris","scopes":"puzzle:read"}}`)
+	mux.HandleFunc("GET /api/account/email", func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("the request must not be sent")
+	})
+
+	client = client.WithAuthToken("lip_token")
+
+	for range 2 {
+		_, resp, err := client.Account.GetEmail(context.Background())
+
+		var scopeErr *lichess.MissingScopeError
+		require.ErrorAs(t, err, &scopeErr)
+		assert.Equal(t, []lichess.Scope{lichess.ScopeEmailRead}, scopeErr.Required)
+		assert.Equal(t, []lichess.Scope{lichess.ScopeEmailRead}, scopeErr.Missing)
+		assert.Equal(t, "/api/account/email", scopeErr.Request.URL.Path)
+		assert.Contains(t, err.Error(), "email:read")
+		assert.Nil(t, resp)
+	}
+
+	assert.Equal(t, int32(1), calls.Load(), "the token info must be cached")
+}
+
+func TestCheckScopes_granted(t *testing.T) {
+	t.Parallel()
+
+	client, mux, calls := setupScopes(t, `{"lip_token":{"userId":"chucknorris","scopes":"email:read,puzzle:read"}}`)
+	mux.HandleFunc("GET /api/account/email", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"email":"chuck@norris.com"}`))
+	})
+	mux.HandleFunc("GET /api/account", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"chucknorris"}`))
+	})
+
+	client = client.WithAuthToken("lip_token")
+
+	email, _, err := client.Account.GetEmail(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "chuck@norris.com", email)
+
+	// Endpoints that don't require any scope aren't checked.
+	_, _, err = client.Account.GetAccount(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestCheckScopes_unchecked(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		token     string
+		wantCalls int32
+	}{
+		// Lichess replies as usual, so the error is reported by the endpoint.
+		"invalid token": {token: "lip_invalid", wantCalls: 1},
+		"no token":      {token: "", wantCalls: 0},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, calls := setupScopes(t, `{"lip_invalid":null}`)
+			mux.HandleFunc("GET /api/account/email", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			})
+
+			if tc.token != "" {
+				client = client.WithAuthToken(tc.token)
+			}
+
+			_, _, err := client.Account.GetEmail(context.Background())
+			require.ErrorIs(t, err, lichess.ErrUnauthorized)
+			assert.Equal(t, tc.wantCalls, calls.Load())
+		})
+	}
+}
+
+func TestCheckScopes_disabled(t *testing.T) {
+	t.Parallel()
+
+	client, mux, calls := setupScopes(t, `{"lip_token":{"userId":"chucknorris","scopes":""}}`)
+	client.CheckScopes = false
+
+	mux.HandleFunc("GET /api/account/email", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"email":"chuck@norris.com"}`))
+	})
+
+	_, _, err := client.WithAuthToken("lip_token").Account.GetEmail(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), calls.Load())
+}
@@ -0,0 +1,360 @@
+package lichess
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// ndJsonReaderBufferSize is the initial size of the buffer used to read
+// NDJSON streams. Longer lines are read in multiple chunks.
+const ndJsonReaderBufferSize = 64 * 1024
+
+// ErrLineTooLong is returned when a line of an NDJSON stream
+// is longer than the limit set by [Client.MaxLineSize].
+var ErrLineTooLong = errors.New("lichess: stream line too long")
+
+// Stream is a stream of values of type T, decoded from the lines
+// of a Lichess NDJSON response. Values are only read from the
+// response body on demand, when [Stream.Next] is called.
+//
+// A Stream must be closed once it's no longer needed, with [Stream.Close].
+// It's also closed when the [context.Context] used to create it is done.
+//
+//	stream := lichess.NewStream[*lichess.Game](ctx, resp)
+//	defer stream.Close()
+//
+//	for stream.Next() {
+//		game := stream.Value()
+//		// ...
+//	}
+//
+//	if err := stream.Err(); err != nil {
+//		// ...
+//	}
+type Stream[T any] struct {
+	body   io.ReadCloser
+	reader *ndJsonReader
+	decode func([]byte) (T, error)
+	ctxErr func() error
+
+	value T
+	err   error
+
+	closed    atomic.Bool
+	closeOnce sync.Once
+	closeErr  error
+	done      chan struct{}
+}
+
+// NewStream returns a [Stream] that decodes each line of the [Response]
+// body as JSON into a value of type T. It takes ownership of the body,
+// which is closed when the stream is closed, or when ctx is done.
+func NewStream[T any](ctx context.Context, resp *Response) *Stream[T] {
+	return newStream(ctx, resp, decodeJson[T])
+}
+
+// newStream returns a [Stream] that decodes each line
+// of the [Response] body with the given decode function.
+func newStream[T any](ctx context.Context, resp *Response, decode func([]byte) (T, error)) *Stream[T] {
+	s := &Stream[T]{
+		body:   resp.Body,
+		reader: newNdJsonReader(resp.Body, resp.maxLineSize),
+		decode: decode,
+		ctxErr: ctx.Err,
+		done:   make(chan struct{}),
+	}
+
+	// Closing the body is the only way to
+	// interrupt a blocking read on it.
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = s.Close()
+		case <-s.done:
+		}
+	}()
+
+	return s
+}
+
+// Next advances the stream to the next value, which will then be available
+// through [Stream.Value]. It returns false when the stream ends, either
+// because there are no more values, an error occurred or the stream was
+// closed. Then, [Stream.Err] should be checked.
+func (s *Stream[T]) Next() bool {
+	if s.err != nil {
+		return false
+	}
+
+	for {
+		line, err := s.reader.next()
+		if err != nil {
+			s.fail(err)
+			return false
+		}
+
+		if len(line) == 0 {
+			continue // Keep-alive
+		}
+
+		value, err := s.decode(line)
+		if err != nil {
+			s.fail(&decodeError{err: err})
+			return false
+		}
+
+		s.value = value
+		return true
+	}
+}
+
+// fail ends the stream because of err, which is discarded if it's just a
+// consequence of the stream being closed, or the context being done.
+func (s *Stream[T]) fail(err error) {
+	switch {
+	case s.ctxErr() != nil:
+		// The context being done is the most
+		// likely reason for the stream to end.
+		s.err = s.ctxErr()
+	case s.closed.Load(), errors.Is(err, io.EOF):
+	default:
+		s.err = err
+	}
+
+	// Explicit ignore error.
+	// We might want to revisit this later.
+	_ = s.Close()
+}
+
+// decodeError wraps the error decoding a line of a stream (e.g. a [*json.SyntaxError]),
+// so it can be told apart from connection errors, because reconnecting won't fix it.
+type decodeError struct {
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+// Value returns the current value of the stream,
+// the one decoded by the last call to [Stream.Next].
+func (s *Stream[T]) Value() T {
+	return s.value
+}
+
+// Err returns the error that ended the stream, if any. It returns nil
+// if the stream ended because there were no more values, or because
+// it was closed. If the context was done, it returns ctx.Err().
+// A stream truncated in the middle of a line reports [io.ErrUnexpectedEOF],
+// and a line longer than [Client.MaxLineSize] reports [ErrLineTooLong].
+func (s *Stream[T]) Err() error {
+	return s.err
+}
+
+// Close closes the stream and the underlying [Response] body.
+// It is safe to call Close multiple times, and concurrently with [Stream.Next].
+func (s *Stream[T]) Close() error {
+	s.closeOnce.Do(func() {
+		s.closed.Store(true)
+		close(s.done)
+		s.closeErr = s.body.Close()
+	})
+
+	return s.closeErr
+}
+
+// All returns an iterator over the values of the stream. If the stream ends
+// because of an error, the error is yielded as the last pair, with the zero
+// value of T. The stream is closed once the iteration ends, including when
+// the loop is stopped early.
+//
+// The iterators returned by the services (e.g. [GamesService.UserGames]) have
+// the same semantics, and they send the request once the iteration starts.
+func (s *Stream[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer func() {
+			// Explicit ignore error.
+			// We might want to revisit this later.
+			_ = s.Close()
+		}()
+
+		for s.Next() {
+			if !yield(s.Value(), nil) {
+				return
+			}
+		}
+
+		if err := s.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// pipe sends every value of the stream to ch, until the stream ends or ctx
+// is done, and closes the stream. It returns the error that ended the stream,
+// if any, except for ctx errors, because the caller is the one done with it.
+func (s *Stream[T]) pipe(ctx context.Context, ch chan<- T) error {
+	defer func() {
+		// Explicit ignore error.
+		// We might want to revisit this later.
+		_ = s.Close()
+	}()
+
+	for s.Next() {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ch <- s.Value():
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return s.Err()
+}
+
+// channels returns a channel of values and a channel of errors fed by the
+// stream, for the methods that expose streams as channels. The channel of
+// errors receives at most one error, and both are closed when the stream ends.
+func (s *Stream[T]) channels(ctx context.Context) (chan T, chan error) {
+	ch := make(chan T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		if err := s.pipe(ctx, ch); err != nil {
+			errs <- err
+		}
+	}()
+
+	return ch, errs
+}
+
+// sendTo returns a function that sends values to ch, until ctx
+// is done, in which case it returns false. It can be used as yield.
+func sendTo[T any](ctx context.Context, ch chan<- T) func(T) bool {
+	return func(value T) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case ch <- value:
+			return true
+		}
+	}
+}
+
+// streamSeq returns an iterator that, once iterated, sends the request built
+// by newReq and yields the values decoded from the NDJSON response with decode.
+// Any error building or sending the request is yielded as the only pair.
+func streamSeq[T any](
+	ctx context.Context,
+	c *Client,
+	newReq func() (*http.Request, error),
+	decode func([]byte) (T, error),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stream, err := openStream(ctx, c, newReq, decode)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+
+		stream.All()(yield)
+	}
+}
+
+func decodeJson[T any](data []byte) (T, error) {
+	var v T
+	err := json.Unmarshal(data, &v)
+
+	return v, err
+}
+
+// decodeAs decodes data as a T, returned as the interface I it implements (e.g. a
+// [GameFull] as a [GameStateEvent]), for streams of different types of events.
+func decodeAs[I, T any](data []byte) (I, error) {
+	v, err := decodeJson[T](data)
+	if err != nil {
+		var zero I
+		return zero, err
+	}
+
+	return any(v).(I), nil
+}
+
+// rawEvent returns a copy of the event, to be kept as the raw event when it isn't
+// recognized (yet) by this library, because the underlying buffer is reused.
+func rawEvent(event []byte) json.RawMessage {
+	return bytes.Clone(event)
+}
+
+// ndJsonReader reads the lines of an NDJSON stream, of any length, trimmed of
+// any surrounding whitespace, so empty lines correspond to the keep-alive lines
+// sent by Lichess. Unlike [bufio.Scanner], it has no maximum line size by default.
+type ndJsonReader struct {
+	r       *bufio.Reader
+	maxSize int // Maximum line size, in bytes. No limit if <= 0.
+	line    []byte
+}
+
+func newNdJsonReader(r io.Reader, maxSize int) *ndJsonReader {
+	return &ndJsonReader{r: bufio.NewReaderSize(r, ndJsonReaderBufferSize), maxSize: maxSize}
+}
+
+// next returns the next line of the stream, which is only valid until the next call.
+// It returns [io.EOF] once the stream ends, [io.ErrUnexpectedEOF] if the stream ends
+// in the middle of a line (i.e. the stream is truncated), and an error wrapping
+// [ErrLineTooLong] if the line is longer than the maximum line size.
+func (l *ndJsonReader) next() ([]byte, error) {
+	l.line = l.line[:0]
+
+	for {
+		chunk, err := l.r.ReadSlice('\n')
+		l.line = append(l.line, chunk...)
+
+		if l.maxSize > 0 && len(bytes.TrimSpace(l.line)) > l.maxSize {
+			return nil, fmt.Errorf("%w: longer than %d bytes", ErrLineTooLong, l.maxSize)
+		}
+
+		switch {
+		case err == nil:
+			return bytes.TrimSpace(l.line), nil
+		case errors.Is(err, bufio.ErrBufferFull):
+			continue
+		case errors.Is(err, io.EOF):
+			line := bytes.TrimSpace(l.line)
+			if len(line) == 0 {
+				return nil, io.EOF
+			}
+
+			// The last line is not terminated by a newline, so
+			// it is only accepted if it's a complete JSON value.
+			if !json.Valid(line) {
+				return nil, io.ErrUnexpectedEOF
+			}
+
+			return line, nil
+		default:
+			return nil, err
+		}
+	}
+}
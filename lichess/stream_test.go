@@ -0,0 +1,207 @@
+package lichess_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+// openTestStream sends a request to api/test, served by handler, and returns the response.
+func openTestStream(
+	ctx context.Context,
+	t *testing.T,
+	handler func(w http.ResponseWriter, r *http.Request),
+) (*lichess.Client, *lichess.Response) {
+	t.Helper()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/test", handler)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "api/test")
+	require.NoError(t, err)
+
+	resp, err := client.Do(req, nil)
+	require.NoError(t, err)
+
+	return client, resp
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, resp := openTestStream(ctx, t, func(w http.ResponseWriter, _ *http.Request) {
+		// Empty lines are keep-alive lines.
+		_, _ = w.Write([]byte("{\"id\":\"game1\"}\n\n\n{\"id\":\"game2\"}"))
+	})
+
+	stream := lichess.NewStream[*lichess.Game](ctx, resp)
+
+	require.True(t, stream.Next())
+	assert.Equal(t, "game1", stream.Value().Id)
+	require.True(t, stream.Next())
+	assert.Equal(t, "game2", stream.Value().Id)
+	require.False(t, stream.Next())
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+}
+
+func TestStream_decodeError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, resp := openTestStream(ctx, t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{\"id\":\"game1\"}\n[1, 2]\n{\"id\":\"game2\"}\n"))
+	})
+
+	stream := lichess.NewStream[*lichess.Game](ctx, resp)
+
+	require.True(t, stream.Next())
+	require.False(t, stream.Next())
+	require.Error(t, stream.Err())
+	require.False(t, stream.Next(), "the stream must not resume after an error")
+}
+
+func TestStream_contextCanceled(t *testing.T) {
+	t.Parallel()
+
+	closed := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, resp := openTestStream(ctx, t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{\"id\":\"game1\"}\n"))
+		// Explicit ignore error.
+		_ = http.NewResponseController(w).Flush()
+
+		// The stream never ends, unless the client goes away.
+		<-r.Context().Done()
+		close(closed)
+	})
+
+	stream := lichess.NewStream[*lichess.Game](ctx, resp)
+	require.True(t, stream.Next())
+
+	// Next blocks until the context is canceled.
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	require.False(t, stream.Next())
+	require.ErrorIs(t, stream.Err(), context.Canceled)
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the connection wasn't closed")
+	}
+}
+
+func TestStream_Close(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, resp := openTestStream(ctx, t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{\"id\":\"game1\"}\n"))
+		// Explicit ignore error.
+		_ = http.NewResponseController(w).Flush()
+		<-r.Context().Done()
+	})
+
+	stream := lichess.NewStream[*lichess.Game](ctx, resp)
+	require.True(t, stream.Next())
+
+	require.NoError(t, stream.Close())
+	require.NoError(t, stream.Close())
+	require.False(t, stream.Next())
+	require.NoError(t, stream.Err())
+}
+
+func TestPuzzlesService_GetPuzzleActivity(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/puzzle/activity", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(`{"date":2,"win":true,"puzzle":{"id":"p1"}}
+{"date":1,"puzzle":{"id":"p2"}}
+`))
+	})
+
+	rounds, _, err := client.Puzzles.GetPuzzleActivity(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, rounds, 2)
+	assert.Equal(t, "p1", rounds[0].Puzzle.Id)
+	assert.True(t, rounds[0].Win)
+	assert.Equal(t, "p2", rounds[1].Puzzle.Id)
+}
+
+func TestStream_largeLine(t *testing.T) {
+	t.Parallel()
+
+	// Way above the 64 KiB limit of bufio.Scanner.
+	pgn := strings.Repeat("e4 e5 ", 200_000)
+
+	ctx := context.Background()
+	_, resp := openTestStream(ctx, t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, "{\"id\":\"game1\",\"pgn\":%q}\n{\"id\":\"game2\"}\n", pgn)
+	})
+
+	stream := lichess.NewStream[*lichess.Game](ctx, resp)
+
+	require.True(t, stream.Next())
+	assert.Equal(t, "game1", stream.Value().Id)
+	require.NotNil(t, stream.Value().Pgn)
+	assert.Equal(t, pgn, *stream.Value().Pgn)
+	require.True(t, stream.Next())
+	assert.Equal(t, "game2", stream.Value().Id)
+	require.False(t, stream.Next())
+	require.NoError(t, stream.Err())
+}
+
+func TestStream_maxLineSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client, mux := setup(t)
+	client.MaxLineSize = 100
+
+	mux.HandleFunc("GET /api/test", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, "{\"id\":\"game1\"}\n{\"id\":\"game2\",\"pgn\":%q}\n{\"id\":\"game3\"}\n",
+			strings.Repeat("e4 e5 ", 100))
+	})
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "api/test")
+	require.NoError(t, err)
+
+	resp, err := client.Do(req, nil)
+	require.NoError(t, err)
+
+	stream := lichess.NewStream[*lichess.Game](ctx, resp)
+
+	require.True(t, stream.Next())
+	assert.Equal(t, "game1", stream.Value().Id)
+	require.False(t, stream.Next())
+	require.ErrorIs(t, stream.Err(), lichess.ErrLineTooLong)
+}
+
+func TestPuzzlesService_GetPuzzleActivity_maxLineSize(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	client.MaxLineSize = 100
+
+	mux.HandleFunc("GET /api/puzzle/activity", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, "{\"date\":1,\"puzzle\":{\"id\":\"p1\",\"fen\":%q}}\n", strings.Repeat("8/", 100))
+	})
+
+	_, _, err := client.Puzzles.GetPuzzleActivity(context.Background(), nil)
+	require.ErrorIs(t, err, lichess.ErrLineTooLong)
+}
@@ -0,0 +1,339 @@
+package lichess
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// SwissService handles communication with the Swiss
+// tournament related methods of the Lichess API.
+//
+// Lichess API docs: https://lichess.org/api#tag/Swiss-tournaments
+type SwissService service
+
+// Swiss represents a Lichess Swiss tournament.
+type Swiss struct {
+	Id        string            `json:"id,omitempty"`
+	CreatedBy string            `json:"createdBy,omitempty"`
+	StartsAt  *Timestamp        `json:"startsAt,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Clock     *GameClock        `json:"clock,omitempty"` // Limit and Increment, in seconds.
+	Variant   *ChallengeVariant `json:"variant,omitempty"`
+	Round     int               `json:"round,omitempty"`
+	NbRounds  int               `json:"nbRounds,omitempty"`
+	NbPlayers int               `json:"nbPlayers,omitempty"`
+	NbOngoing int               `json:"nbOngoing,omitempty"`
+	Status    SwissStatus       `json:"status,omitempty"`
+	Rated     bool              `json:"rated,omitempty"`
+	NextRound *SwissNextRound   `json:"nextRound,omitempty"`
+	Verdicts  *SwissVerdicts    `json:"verdicts,omitempty"`
+}
+
+// SwissStatus represents the status of a Lichess Swiss tournament.
+type SwissStatus string
+
+const (
+	SwissCreated  SwissStatus = "created"
+	SwissStarted  SwissStatus = "started"
+	SwissFinished SwissStatus = "finished"
+)
+
+// SwissNextRound represents when the next round of a Lichess Swiss tournament starts.
+type SwissNextRound struct {
+	At *Timestamp `json:"at,omitempty"`
+	In *int       `json:"in,omitempty"` // In seconds.
+}
+
+// SwissVerdicts represents whether the authenticated
+// user meets the entry conditions of a Swiss tournament.
+type SwissVerdicts struct {
+	List []struct {
+		Condition string `json:"condition,omitempty"`
+		Verdict   string `json:"verdict,omitempty"`
+	} `json:"list,omitempty"`
+	Accepted bool `json:"accepted,omitempty"`
+}
+
+// SwissOptions specifies parameters for SwissService.CreateSwiss and
+// SwissService.UpdateSwiss methods. Clock and NbRounds are required to create
+// a tournament.
+type SwissOptions struct {
+	Name     *string    `url:"name,omitempty"`
+	Clock    *GameClock `url:"clock,omitempty"` // Limit (or Initial) and Increment, in seconds.
+	NbRounds *int       `url:"nbRounds,omitempty"`
+	StartsAt *int64     `url:"startsAt,omitempty"` // In milliseconds since epoch.
+	// RoundInterval is the time between rounds, in seconds. Set to 99999999 to
+	// schedule rounds manually, with SwissService.ScheduleNextRound.
+	RoundInterval *int         `url:"roundInterval,omitempty"`
+	Variant       *GameVariant `url:"variant,omitempty"`
+	Position      *string      `url:"position,omitempty"` // Custom initial position, in FEN.
+	Description   *string      `url:"description,omitempty"`
+	Rated         *bool        `url:"rated,omitempty"`
+	Password      *string      `url:"password,omitempty"`
+	// ForbiddenPairings are pairs of usernames, separated by a space,
+	// one pair per line, that must not play together.
+	ForbiddenPairings *string `url:"forbiddenPairings,omitempty"`
+	// ManualPairings are pairs of usernames, separated by a space, one pair
+	// per line, for the next round. Use "username 1" to give a bye.
+	ManualPairings *string `url:"manualPairings,omitempty"`
+	// ChatFor is who can read and write in the chat: 0 nobody, 10 only team
+	// leaders, 20 only team members, and 30 all Lichess players.
+	ChatFor *int `url:"chatFor,omitempty"`
+
+	// Entry conditions.
+	MinRating     *int  `url:"conditions.minRating.rating,omitempty"`
+	MaxRating     *int  `url:"conditions.maxRating.rating,omitempty"`
+	NbRatedGame   *int  `url:"conditions.nbRatedGame.nb,omitempty"`
+	PlayYourGames *bool `url:"conditions.playYourGames,omitempty"`
+}
+
+// CreateSwiss creates a new Swiss tournament for the given team,
+// which the authenticated user must lead.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/apiSwissNew.
+func (s *SwissService) CreateSwiss(ctx context.Context, teamId string, opts *SwissOptions) (*Swiss, *Response, error) {
+	return s.saveSwiss(ctx, fmt.Sprintf("api/swiss/new/%v", teamId), opts)
+}
+
+// UpdateSwiss updates the Swiss tournament identified by id,
+// which must have been created by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/apiSwissUpdate.
+func (s *SwissService) UpdateSwiss(ctx context.Context, id string, opts *SwissOptions) (*Swiss, *Response, error) {
+	return s.saveSwiss(ctx, fmt.Sprintf("api/swiss/%v/edit", id), opts)
+}
+
+func (s *SwissService) saveSwiss(ctx context.Context, u string, opts *SwissOptions) (*Swiss, *Response, error) {
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var swiss *Swiss
+	resp, err := s.client.Do(req, &swiss)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return swiss, resp, nil
+}
+
+// scheduleNextRoundOptions holds the date of the next round.
+type scheduleNextRoundOptions struct {
+	Date int64 `url:"date"`
+}
+
+// ScheduleNextRound sets the date (in milliseconds since epoch) of the next round of the
+// Swiss tournament identified by id, which must have been created by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/apiSwissScheduleNextRound.
+func (s *SwissService) ScheduleNextRound(ctx context.Context, id string, date int64) (*Response, error) {
+	return s.swissAction(ctx, id, "schedule-next-round", scheduleNextRoundOptions{Date: date})
+}
+
+// TerminateSwiss terminates the Swiss tournament identified by id,
+// which must have been created by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/apiSwissTerminate.
+func (s *SwissService) TerminateSwiss(ctx context.Context, id string) (*Response, error) {
+	return s.swissAction(ctx, id, "terminate", nil)
+}
+
+// JoinSwissOptions specifies parameters for SwissService.JoinSwiss method.
+type JoinSwissOptions struct {
+	// Password, if the tournament is private.
+	Password *string `url:"password,omitempty"`
+}
+
+// JoinSwiss joins the Swiss tournament identified by id.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/apiSwissJoin.
+func (s *SwissService) JoinSwiss(ctx context.Context, id string, opts *JoinSwissOptions) (*Response, error) {
+	return s.swissAction(ctx, id, "join", opts)
+}
+
+// WithdrawSwiss leaves the Swiss tournament identified by id, if it has not
+// started yet, or pauses it otherwise, in which case it can be resumed by joining again.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/apiSwissWithdraw.
+func (s *SwissService) WithdrawSwiss(ctx context.Context, id string) (*Response, error) {
+	return s.swissAction(ctx, id, "withdraw", nil)
+}
+
+func (s *SwissService) swissAction(ctx context.Context, id, action string, opts interface{}) (*Response, error) {
+	u := fmt.Sprintf("api/swiss/%v/%v", id, action)
+
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
+
+// GetSwiss gets the Swiss tournament identified by id.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/swiss.
+func (s *SwissService) GetSwiss(ctx context.Context, id string) (*Swiss, *Response, error) {
+	u := fmt.Sprintf("api/swiss/%v", id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var swiss *Swiss
+	resp, err := s.client.Do(req, &swiss)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return swiss, resp, nil
+}
+
+// ExportTRF exports the Swiss tournament identified by id in the
+// Tournament Report File format, used by FIDE-approved pairing programs.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/swissTrf.
+func (s *SwissService) ExportTRF(ctx context.Context, id string) (string, *Response, error) {
+	u := fmt.Sprintf("swiss/%v.trf", id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return "", nil, err
+	}
+
+	req.Header.Set("Accept", "text/plain")
+
+	var trf bytes.Buffer
+	resp, err := s.client.Do(req, &trf)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return trf.String(), resp, nil
+}
+
+// SwissResult represents the result of a player in a Lichess Swiss tournament.
+type SwissResult struct {
+	Rank        int     `json:"rank,omitempty"`
+	Points      float64 `json:"points,omitempty"`
+	TieBreak    float64 `json:"tieBreak,omitempty"`
+	Rating      int     `json:"rating,omitempty"`
+	Username    string  `json:"username,omitempty"`
+	Title       *string `json:"title,omitempty"`
+	Performance *int    `json:"performance,omitempty"`
+	Absent      bool    `json:"absent,omitempty"`
+}
+
+// GetSwissResultsOptions specifies parameters for SwissService.GetResults method.
+type GetSwissResultsOptions struct {
+	// Nb is the maximum number of results. All of them, by default.
+	Nb *int `url:"nb,omitempty"`
+}
+
+// GetResults gets the results of the Swiss tournament identified by id, ordered by rank.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/resultsBySwiss.
+func (s *SwissService) GetResults(
+	ctx context.Context,
+	id string,
+	opts *GetSwissResultsOptions,
+) ([]*SwissResult, *Response, error) {
+	req, err := s.resultsRequest(ctx, id, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var results []*SwissResult
+	resp, err := s.client.Do(req, &results)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return results, resp, nil
+}
+
+// Results returns an iterator over the results of the Swiss tournament identified by id.
+// Equivalent to [SwissService.GetResults] but with range-over-func semantics.
+func (s *SwissService) Results(
+	ctx context.Context,
+	id string,
+	opts *GetSwissResultsOptions,
+) iter.Seq2[*SwissResult, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.resultsRequest(ctx, id, opts)
+	}, decodeJson[*SwissResult])
+}
+
+func (s *SwissService) resultsRequest(
+	ctx context.Context,
+	id string,
+	opts *GetSwissResultsOptions,
+) (*http.Request, error) {
+	u, err := addOptions(fmt.Sprintf("api/swiss/%v/results", id), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
+
+// ExportSwissGamesOptions specifies parameters for SwissService.ExportGames method.
+type ExportSwissGamesOptions struct {
+	ExportOptions
+	// Player only exports the games played by the given username.
+	Player *string `url:"player,omitempty"`
+}
+
+// ExportGames exports the [Game] played in the Swiss tournament identified by id.
+// Find more details at https://lichess.org/api#tag/Swiss-tournaments/operation/gamesBySwiss.
+func (s *SwissService) ExportGames(
+	ctx context.Context,
+	id string,
+	opts *ExportSwissGamesOptions,
+) ([]*Game, *Response, error) {
+	req, err := s.gamesRequest(ctx, id, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var games []*Game
+	resp, err := s.client.Do(req, &games)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return games, resp, nil
+}
+
+// Games returns an iterator over the [Game] played in the Swiss tournament identified by id.
+// Equivalent to [SwissService.ExportGames] but with range-over-func semantics.
+func (s *SwissService) Games(
+	ctx context.Context,
+	id string,
+	opts *ExportSwissGamesOptions,
+) iter.Seq2[*Game, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.gamesRequest(ctx, id, opts)
+	}, decodeJson[*Game])
+}
+
+func (s *SwissService) gamesRequest(
+	ctx context.Context,
+	id string,
+	opts *ExportSwissGamesOptions,
+) (*http.Request, error) {
+	u, err := addOptions(fmt.Sprintf("api/swiss/%v/games", id), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
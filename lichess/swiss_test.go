@@ -0,0 +1,274 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestSwissService_CreateSwiss(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/swiss/new/coders", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"name":                     {"Weekly Swiss"},
+			"clock.limit":              {"180"},
+			"clock.increment":          {"2"},
+			"nbRounds":                 {"7"},
+			"roundInterval":            {"99999999"},
+			"conditions.playYourGames": {"true"},
+		}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{
+			"id": "abcdefgh",
+			"name": "Weekly Swiss",
+			"startsAt": "2026-01-01T10:00:00Z",
+			"clock": {"limit": 180, "increment": 2},
+			"variant": "standard",
+			"nbRounds": 7,
+			"status": "created",
+			"nextRound": {"at": "2026-01-01T10:00:00Z", "in": 60}
+		}`))
+	})
+
+	name, limit, increment, nbRounds, roundInterval, playYourGames := "Weekly Swiss", 180, 2, 7, 99999999, true
+
+	swiss, _, err := client.Swiss.CreateSwiss(context.Background(), "coders", &lichess.SwissOptions{
+		Name:          &name,
+		Clock:         &lichess.GameClock{Limit: &limit, Increment: &increment},
+		NbRounds:      &nbRounds,
+		RoundInterval: &roundInterval,
+		PlayYourGames: &playYourGames,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefgh", swiss.Id)
+	assert.Equal(t, lichess.SwissCreated, swiss.Status)
+	assert.Equal(t, lichess.Standard, swiss.Variant.Key)
+
+	startsAt := time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC)
+	assert.True(t, startsAt.Equal(swiss.StartsAt.Time))
+	require.NotNil(t, swiss.NextRound)
+	assert.True(t, startsAt.Equal(swiss.NextRound.At.Time))
+	assert.Equal(t, 60, *swiss.NextRound.In)
+}
+
+func TestSwissService_UpdateSwiss(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/swiss/abcdefgh/edit", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{"forbiddenPairings": {"alice bob\ncarol dave"}}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{"id":"abcdefgh"}`))
+	})
+
+	pairings := "alice bob\ncarol dave"
+
+	swiss, _, err := client.Swiss.UpdateSwiss(context.Background(), "abcdefgh",
+		&lichess.SwissOptions{ForbiddenPairings: &pairings})
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefgh", swiss.Id)
+}
+
+func TestSwissService_actions(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		path     string
+		wantForm url.Values
+		call     func(client *lichess.Client) (*lichess.Response, error)
+	}{
+		"schedule next round": {
+			path:     "POST /api/swiss/abcdefgh/schedule-next-round",
+			wantForm: url.Values{"date": {"1767261600000"}},
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Swiss.ScheduleNextRound(context.Background(), "abcdefgh", 1767261600000)
+			},
+		},
+		"terminate": {
+			path: "POST /api/swiss/abcdefgh/terminate",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Swiss.TerminateSwiss(context.Background(), "abcdefgh")
+			},
+		},
+		"join": {
+			path:     "POST /api/swiss/abcdefgh/join",
+			wantForm: url.Values{"password": {"secret"}},
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				password := "secret"
+				return client.Swiss.JoinSwiss(context.Background(), "abcdefgh",
+					&lichess.JoinSwissOptions{Password: &password})
+			},
+		},
+		"withdraw": {
+			path: "POST /api/swiss/abcdefgh/withdraw",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Swiss.WithdrawSwiss(context.Background(), "abcdefgh")
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc(tc.path, func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				if tc.wantForm != nil {
+					assert.Equal(t, tc.wantForm, r.PostForm)
+				}
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			})
+
+			_, err := tc.call(client)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSwissService_GetSwiss(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/swiss/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "abcdefgh",
+			"startsAt": 1767261600000,
+			"round": 3,
+			"nbRounds": 7,
+			"nbOngoing": 4,
+			"status": "started",
+			"verdicts": {"list": [{"condition": "Play your games", "verdict": "ok"}], "accepted": true}
+		}`))
+	})
+
+	swiss, _, err := client.Swiss.GetSwiss(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+	assert.Equal(t, lichess.SwissStarted, swiss.Status)
+	assert.Equal(t, 3, swiss.Round)
+	assert.Equal(t, 4, swiss.NbOngoing)
+	assert.True(t, time.UnixMilli(1767261600000).Equal(swiss.StartsAt.Time))
+	require.NotNil(t, swiss.Verdicts)
+	assert.True(t, swiss.Verdicts.Accepted)
+	require.Len(t, swiss.Verdicts.List, 1)
+	assert.Equal(t, "ok", swiss.Verdicts.List[0].Verdict)
+}
+
+func TestSwissService_ExportTRF(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /swiss/abcdefgh.trf", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/plain", r.Header.Get("Accept"))
+		_, _ = w.Write([]byte("012 Weekly Swiss\n062 2\n"))
+	})
+
+	trf, _, err := client.Swiss.ExportTRF(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+	assert.Equal(t, "012 Weekly Swiss\n062 2\n", trf)
+}
+
+func TestSwissService_ExportTRF_notFound(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /swiss/abcdefgh.trf", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	trf, _, err := client.Swiss.ExportTRF(context.Background(), "abcdefgh")
+	require.ErrorIs(t, err, lichess.ErrNotFound)
+	assert.Empty(t, trf)
+}
+
+func TestSwissService_GetResults(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/swiss/abcdefgh/results", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		assert.Equal(t, "2", r.URL.Query().Get("nb"))
+
+		_, _ = w.Write([]byte(`{"rank":1,"points":2.5,"tieBreak":4.75,"username":"alice","performance":2010}
+{"rank":2,"points":1,"username":"bob","absent":true}
+`))
+	})
+
+	nb := 2
+
+	results, _, err := client.Swiss.GetResults(context.Background(), "abcdefgh", &lichess.GetSwissResultsOptions{Nb: &nb})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.InDelta(t, 2.5, results[0].Points, 0)
+	assert.InDelta(t, 4.75, results[0].TieBreak, 0)
+	assert.Equal(t, 2010, *results[0].Performance)
+	assert.True(t, results[1].Absent)
+	assert.Nil(t, results[1].Performance)
+}
+
+func TestSwissService_Results(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/swiss/abcdefgh/results", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"rank":1,"username":"alice"}
+{"rank":2,"username":"bob"}
+`))
+	})
+
+	var got []string
+	for result, err := range client.Swiss.Results(context.Background(), "abcdefgh", nil) {
+		require.NoError(t, err)
+		got = append(got, result.Username)
+	}
+
+	assert.Equal(t, []string{"alice", "bob"}, got)
+}
+
+func TestSwissService_ExportGames(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/swiss/abcdefgh/games", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		assert.Equal(t, url.Values{"player": {"alice"}, "clocks": {"true"}}, r.URL.Query())
+
+		_, _ = w.Write([]byte(`{"id":"game1","swiss":"abcdefgh"}
+{"id":"game2","swiss":"abcdefgh"}
+`))
+	})
+
+	player, clocks := "alice", true
+	opts := &lichess.ExportSwissGamesOptions{
+		ExportOptions: lichess.ExportOptions{Clocks: &clocks},
+		Player:        &player,
+	}
+
+	games, _, err := client.Swiss.ExportGames(context.Background(), "abcdefgh", opts)
+	require.NoError(t, err)
+	require.Len(t, games, 2)
+	assert.Equal(t, "abcdefgh", *games[1].Swiss)
+
+	var ids []string
+	for game, err := range client.Swiss.Games(context.Background(), "abcdefgh", opts) {
+		require.NoError(t, err)
+		ids = append(ids, game.Id)
+		if len(ids) == 1 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"game1"}, ids)
+}
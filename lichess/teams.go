@@ -0,0 +1,309 @@
+package lichess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// TeamsService handles communication with the team related
+// methods of the Lichess API.
+//
+// Lichess API docs: https://lichess.org/api#tag/Teams
+type TeamsService service
+
+// Team represents a Lichess team.
+type Team struct {
+	Id          string       `json:"id,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Flair       *string      `json:"flair,omitempty"`
+	Open        bool         `json:"open,omitempty"` // Whether joining the team doesn't require approval.
+	Leader      *LightUser   `json:"leader,omitempty"`
+	Leaders     []*LightUser `json:"leaders,omitempty"`
+	NbMembers   int          `json:"nbMembers,omitempty"`
+	Joined      bool         `json:"joined,omitempty"`    // Whether the authenticated user is a member.
+	Requested   bool         `json:"requested,omitempty"` // Whether the authenticated user requested to join.
+}
+
+// TeamPage represents a page of Lichess teams.
+type TeamPage struct {
+	CurrentPage        int     `json:"currentPage,omitempty"`
+	MaxPerPage         int     `json:"maxPerPage,omitempty"`
+	CurrentPageResults []*Team `json:"currentPageResults,omitempty"`
+	PreviousPage       *int    `json:"previousPage,omitempty"`
+	NextPage           *int    `json:"nextPage,omitempty"`
+	NbResults          int     `json:"nbResults,omitempty"`
+	NbPages            int     `json:"nbPages,omitempty"`
+}
+
+// GetTeam gets the team identified by id.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamShow.
+func (s *TeamsService) GetTeam(ctx context.Context, id string) (*Team, *Response, error) {
+	u := fmt.Sprintf("api/team/%v", id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var team *Team
+	resp, err := s.client.Do(req, &team)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return team, resp, nil
+}
+
+// GetGameUserTeam gets the team the given player played for, in a team battle,
+// as referenced by [GameUser].Team. It fails without sending any request, if the
+// player didn't play for a team.
+func (s *TeamsService) GetGameUserTeam(ctx context.Context, player *GameUser) (*Team, *Response, error) {
+	if player == nil || player.Team == nil {
+		return nil, nil, errors.New("game user has no team")
+	}
+
+	return s.GetTeam(ctx, *player.Team)
+}
+
+// GetUserTeams gets the teams the user identified by username is a member of.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamOfUsername.
+func (s *TeamsService) GetUserTeams(ctx context.Context, username string) ([]*Team, *Response, error) {
+	u := fmt.Sprintf("api/team/of/%v", username)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var teams []*Team
+	resp, err := s.client.Do(req, &teams)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return teams, resp, nil
+}
+
+// ListTeamsOptions specifies parameters for TeamsService.GetPopularTeams method.
+type ListTeamsOptions struct {
+	Page *int `url:"page,omitempty"`
+}
+
+// GetPopularTeams gets the most popular teams, paginated.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamAll.
+func (s *TeamsService) GetPopularTeams(ctx context.Context, opts *ListTeamsOptions) (*TeamPage, *Response, error) {
+	return s.listTeams(ctx, "api/team/all", opts)
+}
+
+// searchTeamsOptions holds the text to search for, as well as the optional parameters.
+type searchTeamsOptions struct {
+	Text              string `url:"text"`
+	*ListTeamsOptions `url:",omitempty"`
+}
+
+// SearchTeams searches for teams whose name or description matches the given text, paginated.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamSearch.
+func (s *TeamsService) SearchTeams(
+	ctx context.Context,
+	text string,
+	opts *ListTeamsOptions,
+) (*TeamPage, *Response, error) {
+	return s.listTeams(ctx, "api/team/search", searchTeamsOptions{Text: text, ListTeamsOptions: opts})
+}
+
+func (s *TeamsService) listTeams(ctx context.Context, u string, opts interface{}) (*TeamPage, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var page *TeamPage
+	resp, err := s.client.Do(req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return page, resp, nil
+}
+
+// TeamMember represents a member of a Lichess team.
+type TeamMember struct {
+	User
+	JoinedTeamAt *Timestamp `json:"joinedTeamAt,omitempty"`
+}
+
+// GetMembersOptions specifies parameters for TeamsService.GetMembers method.
+type GetMembersOptions struct {
+	// Full includes the full information of every member, like in UsersService.GetUser,
+	// instead of just their basic information. It limits the stream to 1,000 members.
+	Full *bool `url:"full,omitempty"`
+}
+
+// GetMembers gets the members of the team identified by id, most recent first.
+// Private teams require authentication, as a member of the team, with the team:read scope.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamIdUsers.
+func (s *TeamsService) GetMembers(
+	ctx context.Context,
+	id string,
+	opts *GetMembersOptions,
+) ([]*TeamMember, *Response, error) {
+	req, err := s.membersRequest(ctx, id, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var members []*TeamMember
+	resp, err := s.client.Do(req, &members)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return members, resp, nil
+}
+
+// Members returns an iterator over the members of the team identified by id.
+// Equivalent to [TeamsService.GetMembers] but with range-over-func semantics.
+func (s *TeamsService) Members(ctx context.Context, id string, opts *GetMembersOptions) iter.Seq2[*TeamMember, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.membersRequest(ctx, id, opts)
+	}, decodeJson[*TeamMember])
+}
+
+func (s *TeamsService) membersRequest(
+	ctx context.Context,
+	id string,
+	opts *GetMembersOptions,
+) (*http.Request, error) {
+	u, err := addOptions(fmt.Sprintf("api/team/%v/users", id), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
+
+// JoinTeamOptions specifies parameters for TeamsService.JoinTeam method.
+type JoinTeamOptions struct {
+	// Message for the team leaders, required if the team requires approval to join.
+	Message *string `url:"message,omitempty"`
+	// Password, if the team requires one to join.
+	Password *string `url:"password,omitempty"`
+}
+
+// JoinTeam joins the team identified by id, or requests to join it,
+// if the team requires approval.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamIdJoin.
+func (s *TeamsService) JoinTeam(ctx context.Context, id string, opts *JoinTeamOptions) (*Response, error) {
+	return s.teamAction(ctx, fmt.Sprintf("team/%v/join", id), opts)
+}
+
+// LeaveTeam leaves the team identified by id.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamIdQuit.
+func (s *TeamsService) LeaveTeam(ctx context.Context, id string) (*Response, error) {
+	return s.teamAction(ctx, fmt.Sprintf("team/%v/quit", id), nil)
+}
+
+// TeamJoinRequest represents a request to join a Lichess team.
+type TeamJoinRequest struct {
+	Request *TeamRequest `json:"request,omitempty"`
+	User    *User        `json:"user,omitempty"`
+}
+
+// TeamRequest represents the details of a request to join a Lichess team.
+type TeamRequest struct {
+	TeamId  string     `json:"teamId,omitempty"`
+	UserId  string     `json:"userId,omitempty"`
+	Date    *Timestamp `json:"date,omitempty"`
+	Message *string    `json:"message,omitempty"`
+}
+
+// GetJoinRequestsOptions specifies parameters for TeamsService.GetJoinRequests method.
+type GetJoinRequestsOptions struct {
+	// Declined gets the declined join requests, instead of the pending ones.
+	Declined *bool `url:"declined,omitempty"`
+}
+
+// GetJoinRequests gets the pending requests to join the team identified by id,
+// which must be led by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamRequests.
+func (s *TeamsService) GetJoinRequests(
+	ctx context.Context,
+	id string,
+	opts *GetJoinRequestsOptions,
+) ([]*TeamJoinRequest, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("api/team/%v/requests", id), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*TeamJoinRequest
+	resp, err := s.client.Do(req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
+// AcceptJoinRequest accepts the request of the user identified by userId
+// to join the team identified by id, which must be led by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamRequestAccept.
+func (s *TeamsService) AcceptJoinRequest(ctx context.Context, id, userId string) (*Response, error) {
+	return s.teamAction(ctx, fmt.Sprintf("api/team/%v/request/%v/accept", id, userId), nil)
+}
+
+// DeclineJoinRequest declines the request of the user identified by userId
+// to join the team identified by id, which must be led by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamRequestDecline.
+func (s *TeamsService) DeclineJoinRequest(ctx context.Context, id, userId string) (*Response, error) {
+	return s.teamAction(ctx, fmt.Sprintf("api/team/%v/request/%v/decline", id, userId), nil)
+}
+
+// KickMember kicks the user identified by userId from the team
+// identified by id, which must be led by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamIdKickUserId.
+func (s *TeamsService) KickMember(ctx context.Context, id, userId string) (*Response, error) {
+	return s.teamAction(ctx, fmt.Sprintf("api/team/%v/kick/%v", id, userId), nil)
+}
+
+// messageAllOptions holds the message sent to all the members of a team.
+type messageAllOptions struct {
+	Message string `url:"message"`
+}
+
+// MessageAll sends a private message to all the members of the team
+// identified by id, which must be led by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Teams/operation/teamIdPmAll.
+func (s *TeamsService) MessageAll(ctx context.Context, id, message string) (*Response, error) {
+	return s.teamAction(ctx, fmt.Sprintf("team/%v/pm-all", id), messageAllOptions{Message: message})
+}
+
+func (s *TeamsService) teamAction(ctx context.Context, u string, opts interface{}) (*Response, error) {
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
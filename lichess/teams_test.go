@@ -0,0 +1,290 @@
+package lichess_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestTeamsService_GetTeam(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/team/coders", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "coders",
+			"name": "Coders",
+			"open": true,
+			"leader": {"id": "alice", "name": "Alice"},
+			"leaders": [{"id": "alice", "name": "Alice"}, {"id": "bob", "name": "Bob"}],
+			"nbMembers": 42,
+			"joined": true
+		}`))
+	})
+
+	team, _, err := client.Teams.GetTeam(context.Background(), "coders")
+	require.NoError(t, err)
+	assert.Equal(t, "Coders", team.Name)
+	assert.True(t, team.Open)
+	assert.Equal(t, "alice", team.Leader.Id)
+	assert.Len(t, team.Leaders, 2)
+	assert.Equal(t, 42, team.NbMembers)
+	assert.True(t, team.Joined)
+	assert.False(t, team.Requested)
+}
+
+func TestTeamsService_GetGameUserTeam(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+
+	var calls atomic.Int32
+	mux.HandleFunc("GET /api/team/coders", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{"id":"coders","name":"Coders"}`))
+	})
+
+	var game lichess.Game
+	err := json.Unmarshal([]byte(`{"players":{"white":{"team":"coders"},"black":{}}}`), &game)
+	require.NoError(t, err)
+
+	team, _, err := client.Teams.GetGameUserTeam(context.Background(), &game.Players.White)
+	require.NoError(t, err)
+	assert.Equal(t, "Coders", team.Name)
+
+	// No request is sent for players who didn't play for a team.
+	_, _, err = client.Teams.GetGameUserTeam(context.Background(), &game.Players.Black)
+	require.Error(t, err)
+	_, _, err = client.Teams.GetGameUserTeam(context.Background(), nil)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestTeamsService_GetUserTeams(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/team/of/alice", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":"coders"},{"id":"chess-club"}]`))
+	})
+
+	teams, _, err := client.Teams.GetUserTeams(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Len(t, teams, 2)
+	assert.Equal(t, "chess-club", teams[1].Id)
+}
+
+func TestTeamsService_listTeams(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		path      string
+		wantQuery url.Values
+		call      func(client *lichess.Client, opts *lichess.ListTeamsOptions) (*lichess.TeamPage, *lichess.Response, error)
+	}{
+		"popular": {
+			path:      "GET /api/team/all",
+			wantQuery: url.Values{"page": {"2"}},
+			call: func(client *lichess.Client, opts *lichess.ListTeamsOptions) (*lichess.TeamPage, *lichess.Response, error) {
+				return client.Teams.GetPopularTeams(context.Background(), opts)
+			},
+		},
+		"search": {
+			path:      "GET /api/team/search",
+			wantQuery: url.Values{"text": {"chess club"}, "page": {"2"}},
+			call: func(client *lichess.Client, opts *lichess.ListTeamsOptions) (*lichess.TeamPage, *lichess.Response, error) {
+				return client.Teams.SearchTeams(context.Background(), "chess club", opts)
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc(tc.path, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.wantQuery, r.URL.Query())
+				_, _ = w.Write([]byte(`{
+					"currentPage": 2,
+					"maxPerPage": 15,
+					"currentPageResults": [{"id": "chess-club", "name": "Chess Club"}],
+					"previousPage": 1,
+					"nextPage": null,
+					"nbResults": 16,
+					"nbPages": 2
+				}`))
+			})
+
+			page := 2
+
+			teams, _, err := tc.call(client, &lichess.ListTeamsOptions{Page: &page})
+			require.NoError(t, err)
+			assert.Equal(t, 2, teams.CurrentPage)
+			require.Len(t, teams.CurrentPageResults, 1)
+			assert.Equal(t, "Chess Club", teams.CurrentPageResults[0].Name)
+			assert.Equal(t, 1, *teams.PreviousPage)
+			assert.Nil(t, teams.NextPage)
+		})
+	}
+}
+
+func TestTeamsService_SearchTeams_noOptions(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/team/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, url.Values{"text": {"coders"}}, r.URL.Query())
+		_, _ = w.Write([]byte(`{"currentPage":1}`))
+	})
+
+	_, _, err := client.Teams.SearchTeams(context.Background(), "coders", nil)
+	require.NoError(t, err)
+}
+
+func TestTeamsService_GetMembers(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/team/coders/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		assert.Equal(t, "true", r.URL.Query().Get("full"))
+
+		_, _ = w.Write([]byte(`{"id":"alice","username":"Alice","joinedTeamAt":1700000000000}
+{"id":"bob","username":"Bob","joinedTeamAt":1690000000000}
+`))
+	})
+
+	full := true
+	opts := &lichess.GetMembersOptions{Full: &full}
+
+	members, _, err := client.Teams.GetMembers(context.Background(), "coders", opts)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "Alice", members[0].Username)
+	assert.True(t, time.UnixMilli(1700000000000).Equal(members[0].JoinedTeamAt.Time))
+
+	var got []string
+	for member, err := range client.Teams.Members(context.Background(), "coders", opts) {
+		require.NoError(t, err)
+		got = append(got, member.Id)
+	}
+
+	assert.Equal(t, []string{"alice", "bob"}, got)
+}
+
+func TestTeamsService_Members_errorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/team/private/users", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	for _, err := range client.Teams.Members(context.Background(), "private", nil) {
+		require.ErrorIs(t, err, lichess.ErrForbidden)
+	}
+}
+
+func TestTeamsService_GetJoinRequests(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/team/coders/requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("declined"))
+
+		_, _ = w.Write([]byte(`[{
+			"request": {"teamId": "coders", "userId": "carol", "date": 1700000000000, "message": "Hi!"},
+			"user": {"id": "carol", "username": "Carol"}
+		}]`))
+	})
+
+	declined := true
+
+	requests, _, err := client.Teams.GetJoinRequests(context.Background(), "coders",
+		&lichess.GetJoinRequestsOptions{Declined: &declined})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "carol", requests[0].Request.UserId)
+	assert.Equal(t, "Hi!", *requests[0].Request.Message)
+	assert.True(t, time.UnixMilli(1700000000000).Equal(requests[0].Request.Date.Time))
+	assert.Equal(t, "Carol", requests[0].User.Username)
+}
+
+func TestTeamsService_actions(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		path     string
+		wantForm url.Values
+		call     func(client *lichess.Client) (*lichess.Response, error)
+	}{
+		"join": {
+			path:     "POST /team/coders/join",
+			wantForm: url.Values{"message": {"Let me in"}, "password": {"secret"}},
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				message, password := "Let me in", "secret"
+				return client.Teams.JoinTeam(context.Background(), "coders",
+					&lichess.JoinTeamOptions{Message: &message, Password: &password})
+			},
+		},
+		"leave": {
+			path: "POST /team/coders/quit",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Teams.LeaveTeam(context.Background(), "coders")
+			},
+		},
+		"accept join request": {
+			path: "POST /api/team/coders/request/carol/accept",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Teams.AcceptJoinRequest(context.Background(), "coders", "carol")
+			},
+		},
+		"decline join request": {
+			path: "POST /api/team/coders/request/carol/decline",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Teams.DeclineJoinRequest(context.Background(), "coders", "carol")
+			},
+		},
+		"kick member": {
+			path: "POST /api/team/coders/kick/bob",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Teams.KickMember(context.Background(), "coders", "bob")
+			},
+		},
+		"message all": {
+			path:     "POST /team/coders/pm-all",
+			wantForm: url.Values{"message": {"Hello, all!"}},
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Teams.MessageAll(context.Background(), "coders", "Hello, all!")
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc(tc.path, func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				if tc.wantForm != nil {
+					assert.Equal(t, tc.wantForm, r.PostForm)
+				}
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			})
+
+			_, err := tc.call(client)
+			require.NoError(t, err)
+		})
+	}
+}
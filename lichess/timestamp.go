@@ -0,0 +1,39 @@
+package lichess
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// Timestamp represents a point in time sent by Lichess, which some endpoints
+// encode as milliseconds since epoch, and others as an RFC 3339 string.
+type Timestamp struct {
+	time.Time
+}
+
+// UnmarshalJSON decodes either a number of milliseconds
+// since epoch, or an RFC 3339 string.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &t.Time)
+	}
+
+	var millis int64
+	if err := json.Unmarshal(data, &millis); err != nil {
+		return err
+	}
+
+	t.Time = time.UnixMilli(millis)
+
+	return nil
+}
+
+// MarshalJSON encodes the timestamp as milliseconds since epoch.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.UnixMilli())
+}
@@ -0,0 +1,73 @@
+package lichess_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		data    string
+		want    time.Time
+		wantErr bool
+	}{
+		"milliseconds": {
+			data: `1700000000123`,
+			want: time.UnixMilli(1700000000123),
+		},
+		"RFC 3339": {
+			data: `"2023-11-14T22:13:20Z"`,
+			want: time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC),
+		},
+		"null": {
+			data: `null`,
+		},
+		"invalid string": {
+			data:    `"yesterday"`,
+			wantErr: true,
+		},
+		"invalid type": {
+			data:    `true`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var ts lichess.Timestamp
+			err := json.Unmarshal([]byte(tc.data), &ts)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(ts.Time), "got %v, want %v", ts.Time, tc.want)
+		})
+	}
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	ts := lichess.Timestamp{Time: time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC)}
+
+	data, err := json.Marshal(ts)
+	require.NoError(t, err)
+	assert.Equal(t, `1700000000000`, string(data))
+
+	// Timestamps are always encoded as milliseconds, whatever they were decoded from.
+	var decoded lichess.Timestamp
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, ts.Equal(decoded.Time))
+}
@@ -0,0 +1,59 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TokenSource supplies the OAuth token used to authenticate requests.
+// Token is called before sending every request (including retries), so
+// implementations can refresh or rotate tokens, and should cache them
+// if getting a new one is expensive. It must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of
+// ordinary functions as a [TokenSource].
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// staticTokenSource is a [TokenSource] that always returns the same token.
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// token returns the token used to authenticate the given
+// request, or an empty string if the client has no token source.
+func (c *Client) token(req *http.Request) (string, error) {
+	if c.tokenSource == nil {
+		return "", nil
+	}
+
+	token, err := c.tokenSource.Token(req.Context())
+	if err != nil {
+		return "", fmt.Errorf("lichess: getting token: %w", err)
+	}
+
+	return token, nil
+}
+
+// authorize sets the Authorization header of the given request,
+// if the client has a token source and it returns a non-empty token.
+func (c *Client) authorize(req *http.Request) error {
+	token, err := c.token(req)
+	if err != nil || token == "" {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	return nil
+}
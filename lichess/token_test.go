@@ -0,0 +1,129 @@
+package lichess_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestClient_WithAuthToken(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	client.Header = http.Header{"X-Trace": {"base"}}
+
+	echoAuthorization := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"` + r.Header.Get("Authorization") + `"}`))
+	}
+	mux.HandleFunc("GET /api/account", echoAuthorization)
+	mux.HandleFunc("GET /alice/api/account", echoAuthorization)
+
+	alice := client.WithAuthToken("alice_token")
+	bob := client.WithAuthToken("bob_token")
+
+	// Changes to the copies don't affect the original client, nor each other.
+	alice.Header.Set("X-Trace", "alice")
+	alice.BaseURL.Path = "/alice/"
+
+	assert.Equal(t, "base", client.Header.Get("X-Trace"))
+	assert.Equal(t, "base", bob.Header.Get("X-Trace"))
+	assert.Equal(t, "/", client.BaseURL.Path)
+	assert.Equal(t, "/", bob.BaseURL.Path)
+	assert.NotSame(t, client.Account, bob.Account)
+
+	tcs := map[string]struct {
+		client *lichess.Client
+		want   string
+	}{
+		"base":  {client: client, want: ""},
+		"alice": {client: alice, want: "Bearer alice_token"},
+		"bob":   {client: bob, want: "Bearer bob_token"},
+	}
+
+	for name, tc := range tcs {
+		user, _, err := tc.client.Account.GetAccount(context.Background())
+		require.NoError(t, err, name)
+		assert.Equal(t, tc.want, user.Id, name)
+	}
+}
+
+func TestClient_WithAuthToken_sharedRateLimit(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	alice := client.WithAuthToken("alice_token")
+	bob := client.WithAuthToken("bob_token")
+
+	_, _, err := alice.Account.GetAccount(context.Background())
+
+	var rateErr *lichess.RateLimitError
+	require.ErrorAs(t, err, &rateErr)
+
+	// The cool-down applies to the original client, and to any other copy.
+	for _, c := range []*lichess.Client{client, bob, client.WithAuthToken("carol_token")} {
+		assert.True(t, c.RateLimit().Limited())
+		assert.Equal(t, rateErr.Reset, c.RateLimit().Reset)
+
+		_, _, err = c.Account.GetAccount(context.Background())
+		require.ErrorAs(t, err, &rateErr)
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_WithTokenSource(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"` + r.Header.Get("Authorization") + `"}`))
+	})
+
+	var calls atomic.Int32
+	client = client.WithTokenSource(lichess.TokenSourceFunc(func(context.Context) (string, error) {
+		// The token is asked for before sending every request.
+		if calls.Add(1) == 1 {
+			return "first_token", nil
+		}
+		return "second_token", nil
+	}))
+
+	user, _, err := client.Account.GetAccount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer first_token", user.Id)
+
+	user, _, err = client.Account.GetAccount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer second_token", user.Id)
+}
+
+func TestClient_WithTokenSource_error(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/account", func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("the request must not be sent")
+	})
+
+	errToken := errors.New("token store unavailable")
+	client = client.WithTokenSource(lichess.TokenSourceFunc(func(context.Context) (string, error) {
+		return "", errToken
+	}))
+
+	_, _, err := client.Account.GetAccount(context.Background())
+	require.ErrorIs(t, err, errToken)
+}
@@ -0,0 +1,436 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// TournamentsService handles communication with the arena
+// tournament related methods of the Lichess API.
+//
+// Lichess API docs: https://lichess.org/api#tag/Arena-tournaments
+type TournamentsService service
+
+// Tournament represents a Lichess arena tournament.
+type Tournament struct {
+	Id        string            `json:"id,omitempty"`
+	CreatedBy string            `json:"createdBy,omitempty"`
+	System    string            `json:"system,omitempty"`
+	FullName  string            `json:"fullName,omitempty"`
+	Minutes   int               `json:"minutes,omitempty"`
+	Clock     *GameClock        `json:"clock,omitempty"` // Limit and Increment, in seconds.
+	Rated     bool              `json:"rated,omitempty"`
+	Variant   *ChallengeVariant `json:"variant,omitempty"`
+	Perf      *TournamentPerf   `json:"perf,omitempty"`
+	NbPlayers int               `json:"nbPlayers,omitempty"`
+	// Status is 10 if created, 20 if started, and 30 if finished.
+	Status          int                   `json:"status,omitempty"`
+	StartsAt        *Timestamp            `json:"startsAt,omitempty"`
+	FinishesAt      *Timestamp            `json:"finishesAt,omitempty"`
+	SecondsToStart  *int                  `json:"secondsToStart,omitempty"`
+	SecondsToFinish *int                  `json:"secondsToFinish,omitempty"`
+	IsStarted       bool                  `json:"isStarted,omitempty"`
+	IsFinished      bool                  `json:"isFinished,omitempty"`
+	Description     *string               `json:"description,omitempty"`
+	Berserkable     bool                  `json:"berserkable,omitempty"`
+	HasMaxRating    bool                  `json:"hasMaxRating,omitempty"`
+	Private         bool                  `json:"private,omitempty"`
+	Winner          *LightUser            `json:"winner,omitempty"`
+	TeamBattle      *TournamentTeamBattle `json:"teamBattle,omitempty"`
+	Standing        *TournamentStanding   `json:"standing,omitempty"` // Only sent by TournamentsService.GetTournament.
+}
+
+// TournamentPerf represents the perf type a Lichess tournament is rated in.
+type TournamentPerf struct {
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// TournamentTeamBattle represents the teams of a Lichess team battle,
+// whose names are keyed by team identifier.
+type TournamentTeamBattle struct {
+	Teams     map[string]string `json:"teams,omitempty"`
+	NbLeaders int               `json:"nbLeaders,omitempty"`
+}
+
+// TournamentStanding represents a page of the live standing of a Lichess tournament.
+type TournamentStanding struct {
+	Page    int                 `json:"page,omitempty"`
+	Players []*TournamentPlayer `json:"players,omitempty"`
+}
+
+// TournamentPlayer represents a player in the standing of a Lichess tournament.
+type TournamentPlayer struct {
+	Name     string           `json:"name,omitempty"`
+	Title    *string          `json:"title,omitempty"`
+	Rank     int              `json:"rank,omitempty"`
+	Rating   int              `json:"rating,omitempty"`
+	Score    int              `json:"score,omitempty"`
+	Team     *string          `json:"team,omitempty"`
+	Withdraw bool             `json:"withdraw,omitempty"`
+	Sheet    *TournamentSheet `json:"sheet,omitempty"`
+}
+
+// TournamentSheet represents the scores of every game played
+// by a player in a Lichess tournament, e.g. "20245".
+type TournamentSheet struct {
+	Scores string `json:"scores,omitempty"`
+	Fire   bool   `json:"fire,omitempty"` // On a winning streak.
+}
+
+// CurrentTournaments represents the tournaments
+// recently finished, ongoing, and starting soon.
+type CurrentTournaments struct {
+	Created  []*Tournament `json:"created,omitempty"`
+	Started  []*Tournament `json:"started,omitempty"`
+	Finished []*Tournament `json:"finished,omitempty"`
+}
+
+// GetCurrentTournaments gets the tournaments recently finished, ongoing, and starting soon.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/apiTournament.
+func (s *TournamentsService) GetCurrentTournaments(ctx context.Context) (*CurrentTournaments, *Response, error) {
+	u := "api/tournament"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tournaments *CurrentTournaments
+	resp, err := s.client.Do(req, &tournaments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tournaments, resp, nil
+}
+
+// TournamentOptions specifies parameters for TournamentsService.CreateTournament
+// and TournamentsService.UpdateTournament methods. ClockTime, ClockIncrement and
+// Minutes are required to create a tournament.
+type TournamentOptions struct {
+	Name           *string  `url:"name,omitempty"`
+	ClockTime      *float64 `url:"clockTime,omitempty"`      // In minutes.
+	ClockIncrement *int     `url:"clockIncrement,omitempty"` // In seconds.
+	Minutes        *int     `url:"minutes,omitempty"`        // Duration of the tournament.
+	// WaitMinutes is the time before the tournament starts. Ignored if StartDate is set.
+	WaitMinutes *int         `url:"waitMinutes,omitempty"`
+	StartDate   *int64       `url:"startDate,omitempty"` // In milliseconds since epoch.
+	Variant     *GameVariant `url:"variant,omitempty"`
+	Rated       *bool        `url:"rated,omitempty"`
+	Position    *string      `url:"position,omitempty"` // Custom initial position, in FEN.
+	Berserkable *bool        `url:"berserkable,omitempty"`
+	Streakable  *bool        `url:"streakable,omitempty"`
+	HasChat     *bool        `url:"hasChat,omitempty"`
+	Description *string      `url:"description,omitempty"`
+	Password    *string      `url:"password,omitempty"`
+	// TeamBattleByTeam creates a team battle, with the given team as the first one.
+	TeamBattleByTeam *string `url:"teamBattleByTeam,omitempty"`
+
+	// Entry conditions.
+	TeamMember  *string `url:"conditions.teamMember.teamId,omitempty"`
+	MinRating   *int    `url:"conditions.minRating.rating,omitempty"`
+	MaxRating   *int    `url:"conditions.maxRating.rating,omitempty"`
+	NbRatedGame *int    `url:"conditions.nbRatedGame.nb,omitempty"`
+}
+
+// CreateTournament creates a new arena tournament, with the given options.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/apiTournamentPost.
+func (s *TournamentsService) CreateTournament(
+	ctx context.Context,
+	opts *TournamentOptions,
+) (*Tournament, *Response, error) {
+	return s.saveTournament(ctx, "api/tournament", opts)
+}
+
+// UpdateTournament updates the arena tournament identified by id,
+// which must have been created by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/apiTournamentUpdate.
+func (s *TournamentsService) UpdateTournament(
+	ctx context.Context,
+	id string,
+	opts *TournamentOptions,
+) (*Tournament, *Response, error) {
+	return s.saveTournament(ctx, fmt.Sprintf("api/tournament/%v", id), opts)
+}
+
+func (s *TournamentsService) saveTournament(
+	ctx context.Context,
+	u string,
+	opts *TournamentOptions,
+) (*Tournament, *Response, error) {
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tournament *Tournament
+	resp, err := s.client.Do(req, &tournament)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tournament, resp, nil
+}
+
+// TerminateTournament terminates the arena tournament identified by id,
+// which must have been created by the authenticated user.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/apiTournamentTerminate.
+func (s *TournamentsService) TerminateTournament(ctx context.Context, id string) (*Response, error) {
+	return s.tournamentAction(ctx, id, "terminate", nil)
+}
+
+// JoinTournamentOptions specifies parameters for
+// TournamentsService.JoinTournament method.
+type JoinTournamentOptions struct {
+	// Password, if the tournament is private.
+	Password *string `url:"password,omitempty"`
+	// Team to join the team battle with.
+	Team *string `url:"team,omitempty"`
+	// PairMeAsap pairs the user as soon as possible, if the tournament has started.
+	PairMeAsap *bool `url:"pairMeAsap,omitempty"`
+}
+
+// JoinTournament joins the arena tournament identified by id, or resumes it, if paused.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/apiTournamentJoin.
+func (s *TournamentsService) JoinTournament(
+	ctx context.Context,
+	id string,
+	opts *JoinTournamentOptions,
+) (*Response, error) {
+	return s.tournamentAction(ctx, id, "join", opts)
+}
+
+// WithdrawTournament leaves the arena tournament identified by id, if it has not
+// started yet, or pauses it otherwise, in which case it can be resumed by joining again.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/apiTournamentWithdraw.
+func (s *TournamentsService) WithdrawTournament(ctx context.Context, id string) (*Response, error) {
+	return s.tournamentAction(ctx, id, "withdraw", nil)
+}
+
+func (s *TournamentsService) tournamentAction(
+	ctx context.Context,
+	id, action string,
+	opts interface{},
+) (*Response, error) {
+	u := fmt.Sprintf("api/tournament/%v/%v", id, action)
+
+	body, err := formBody(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response body ({"ok":true}) is discarded.
+	return s.client.Do(req, io.Discard)
+}
+
+// GetTournamentOptions specifies parameters for
+// TournamentsService.GetTournament method.
+type GetTournamentOptions struct {
+	// Page of the standing to get. Defaults to 1.
+	Page *int `url:"page,omitempty"`
+}
+
+// GetTournament gets the arena tournament identified by id,
+// along with a page of its live standing.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/tournament.
+func (s *TournamentsService) GetTournament(
+	ctx context.Context,
+	id string,
+	opts *GetTournamentOptions,
+) (*Tournament, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("api/tournament/%v", id), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tournament *Tournament
+	resp, err := s.client.Do(req, &tournament)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tournament, resp, nil
+}
+
+// TournamentResult represents the result of a player in a Lichess tournament.
+type TournamentResult struct {
+	Rank        int              `json:"rank,omitempty"`
+	Score       int              `json:"score,omitempty"`
+	Rating      int              `json:"rating,omitempty"`
+	Username    string           `json:"username,omitempty"`
+	Title       *string          `json:"title,omitempty"`
+	Performance int              `json:"performance,omitempty"`
+	Team        *string          `json:"team,omitempty"`
+	Sheet       *TournamentSheet `json:"sheet,omitempty"`
+}
+
+// GetResultsOptions specifies parameters for TournamentsService.GetResults method.
+type GetResultsOptions struct {
+	// Nb is the maximum number of results. All of them, by default.
+	Nb *int `url:"nb,omitempty"`
+	// Sheet adds the score sheet of every player. It makes the call slower.
+	Sheet *bool `url:"sheet,omitempty"`
+}
+
+// GetResults gets the results of the arena tournament identified by id, ordered by rank.
+// Results are available while the tournament is ongoing, but are cached for a few seconds.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/resultsByTournament.
+func (s *TournamentsService) GetResults(
+	ctx context.Context,
+	id string,
+	opts *GetResultsOptions,
+) ([]*TournamentResult, *Response, error) {
+	req, err := s.resultsRequest(ctx, id, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var results []*TournamentResult
+	resp, err := s.client.Do(req, &results)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return results, resp, nil
+}
+
+// Results returns an iterator over the results of the arena tournament identified by id.
+// Equivalent to [TournamentsService.GetResults] but with range-over-func semantics.
+func (s *TournamentsService) Results(
+	ctx context.Context,
+	id string,
+	opts *GetResultsOptions,
+) iter.Seq2[*TournamentResult, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.resultsRequest(ctx, id, opts)
+	}, decodeJson[*TournamentResult])
+}
+
+func (s *TournamentsService) resultsRequest(
+	ctx context.Context,
+	id string,
+	opts *GetResultsOptions,
+) (*http.Request, error) {
+	u, err := addOptions(fmt.Sprintf("api/tournament/%v/results", id), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
+
+// TournamentTeamStanding represents the standing of the teams of a Lichess team battle.
+type TournamentTeamStanding struct {
+	Id    string            `json:"id,omitempty"`
+	Teams []*TournamentTeam `json:"teams,omitempty"`
+}
+
+// TournamentTeam represents a team in the standing of a Lichess team battle,
+// along with its leaders, the players whose scores count for the team.
+type TournamentTeam struct {
+	Rank    int                     `json:"rank,omitempty"`
+	Id      string                  `json:"id,omitempty"`
+	Score   int                     `json:"score,omitempty"`
+	Players []*TournamentTeamLeader `json:"players,omitempty"`
+}
+
+// TournamentTeamLeader represents a player whose score counts for their team.
+type TournamentTeamLeader struct {
+	User  LightUser `json:"user,omitempty"`
+	Score int       `json:"score,omitempty"`
+}
+
+// GetTeamStanding gets the standing of the teams of the team battle identified by id.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/teamsByTournament.
+func (s *TournamentsService) GetTeamStanding(
+	ctx context.Context,
+	id string,
+) (*TournamentTeamStanding, *Response, error) {
+	u := fmt.Sprintf("api/tournament/%v/teams", id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var standing *TournamentTeamStanding
+	resp, err := s.client.Do(req, &standing)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return standing, resp, nil
+}
+
+// ExportTournamentGamesOptions specifies parameters for
+// TournamentsService.ExportGames method.
+type ExportTournamentGamesOptions struct {
+	ExportOptions
+	// Player only exports the games played by the given username.
+	Player *string `url:"player,omitempty"`
+}
+
+// ExportGames exports the [Game] played in the arena tournament identified by id.
+// Find more details at https://lichess.org/api#tag/Arena-tournaments/operation/gamesByTournament.
+func (s *TournamentsService) ExportGames(
+	ctx context.Context,
+	id string,
+	opts *ExportTournamentGamesOptions,
+) ([]*Game, *Response, error) {
+	req, err := s.gamesRequest(ctx, id, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var games []*Game
+	resp, err := s.client.Do(req, &games)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return games, resp, nil
+}
+
+// Games returns an iterator over the [Game] played in the arena tournament identified by id.
+// Equivalent to [TournamentsService.ExportGames] but with range-over-func semantics.
+func (s *TournamentsService) Games(
+	ctx context.Context,
+	id string,
+	opts *ExportTournamentGamesOptions,
+) iter.Seq2[*Game, error] {
+	return streamSeq(ctx, s.client, func() (*http.Request, error) {
+		return s.gamesRequest(ctx, id, opts)
+	}, decodeJson[*Game])
+}
+
+func (s *TournamentsService) gamesRequest(
+	ctx context.Context,
+	id string,
+	opts *ExportTournamentGamesOptions,
+) (*http.Request, error) {
+	u, err := addOptions(fmt.Sprintf("api/tournament/%v/games", id), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u)
+}
@@ -0,0 +1,311 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestTournamentsService_GetCurrentTournaments(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/tournament", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"created": [{
+				"id": "abcdefgh",
+				"fullName": "Hourly Blitz Arena",
+				"clock": {"limit": 180, "increment": 2},
+				"variant": {"key": "standard", "short": "Std", "name": "Standard"},
+				"perf": {"key": "blitz", "name": "Blitz"},
+				"status": 10,
+				"startsAt": 1700000000000
+			}],
+			"started": [],
+			"finished": [{"id": "ijklmnop", "isFinished": true, "winner": {"id": "alice", "name": "Alice"}}]
+		}`))
+	})
+
+	tournaments, _, err := client.Tournaments.GetCurrentTournaments(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tournaments.Created, 1)
+	require.Len(t, tournaments.Finished, 1)
+	assert.Empty(t, tournaments.Started)
+
+	created := tournaments.Created[0]
+	assert.Equal(t, "Hourly Blitz Arena", created.FullName)
+	assert.Equal(t, 180, *created.Clock.Limit)
+	assert.Equal(t, lichess.Standard, created.Variant.Key)
+	assert.Equal(t, "blitz", created.Perf.Key)
+	assert.Equal(t, 10, created.Status)
+	assert.True(t, time.UnixMilli(1700000000000).Equal(created.StartsAt.Time))
+
+	finished := tournaments.Finished[0]
+	assert.True(t, finished.IsFinished)
+	assert.Equal(t, "alice", finished.Winner.Id)
+}
+
+func TestTournamentsService_CreateTournament(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/tournament", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"name":                         {"Team Arena"},
+			"clockTime":                    {"0.5"},
+			"clockIncrement":               {"0"},
+			"minutes":                      {"60"},
+			"variant":                      {"atomic"},
+			"conditions.teamMember.teamId": {"coders"},
+			"conditions.minRating.rating":  {"1500"},
+		}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{"id":"abcdefgh","fullName":"Team Arena","minutes":60,"variant":"atomic"}`))
+	})
+
+	name, team, variant := "Team Arena", "coders", lichess.Atomic
+	clockTime, increment, minutes, minRating := 0.5, 0, 60, 1500
+
+	tournament, _, err := client.Tournaments.CreateTournament(context.Background(), &lichess.TournamentOptions{
+		Name:           &name,
+		ClockTime:      &clockTime,
+		ClockIncrement: &increment,
+		Minutes:        &minutes,
+		Variant:        &variant,
+		TeamMember:     &team,
+		MinRating:      &minRating,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefgh", tournament.Id)
+	assert.Equal(t, 60, tournament.Minutes)
+	// The variant is sent as a plain key, once created.
+	assert.Equal(t, lichess.Atomic, tournament.Variant.Key)
+}
+
+func TestTournamentsService_UpdateTournament(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/tournament/abcdefgh", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{"description": {"Be nice"}}, r.PostForm)
+
+		_, _ = w.Write([]byte(`{"id":"abcdefgh","description":"Be nice"}`))
+	})
+
+	description := "Be nice"
+
+	tournament, _, err := client.Tournaments.UpdateTournament(context.Background(), "abcdefgh",
+		&lichess.TournamentOptions{Description: &description})
+	require.NoError(t, err)
+	assert.Equal(t, "Be nice", *tournament.Description)
+}
+
+func TestTournamentsService_actions(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		path     string
+		wantForm url.Values
+		call     func(client *lichess.Client) (*lichess.Response, error)
+	}{
+		"terminate": {
+			path: "POST /api/tournament/abcdefgh/terminate",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Tournaments.TerminateTournament(context.Background(), "abcdefgh")
+			},
+		},
+		"join": {
+			path:     "POST /api/tournament/abcdefgh/join",
+			wantForm: url.Values{"password": {"secret"}, "team": {"coders"}, "pairMeAsap": {"true"}},
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				password, team, pairMeAsap := "secret", "coders", true
+				return client.Tournaments.JoinTournament(context.Background(), "abcdefgh",
+					&lichess.JoinTournamentOptions{Password: &password, Team: &team, PairMeAsap: &pairMeAsap})
+			},
+		},
+		"withdraw": {
+			path: "POST /api/tournament/abcdefgh/withdraw",
+			call: func(client *lichess.Client) (*lichess.Response, error) {
+				return client.Tournaments.WithdrawTournament(context.Background(), "abcdefgh")
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc(tc.path, func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				if tc.wantForm != nil {
+					assert.Equal(t, tc.wantForm, r.PostForm)
+				}
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			})
+
+			_, err := tc.call(client)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTournamentsService_GetTournament(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/tournament/abcdefgh", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+		_, _ = w.Write([]byte(`{
+			"id": "abcdefgh",
+			"variant": "chess960",
+			"startsAt": "2023-11-14T22:13:20Z",
+			"teamBattle": {"teams": {"coders": "Coders"}, "nbLeaders": 5},
+			"standing": {
+				"page": 2,
+				"players": [{"name": "alice", "rank": 11, "score": 20, "sheet": {"scores": "22020", "fire": true}}]
+			}
+		}`))
+	})
+
+	page := 2
+
+	tournament, _, err := client.Tournaments.GetTournament(context.Background(), "abcdefgh",
+		&lichess.GetTournamentOptions{Page: &page})
+	require.NoError(t, err)
+	assert.Equal(t, lichess.Chess960, tournament.Variant.Key)
+	assert.True(t, time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC).Equal(tournament.StartsAt.Time))
+	assert.Equal(t, map[string]string{"coders": "Coders"}, tournament.TeamBattle.Teams)
+
+	require.NotNil(t, tournament.Standing)
+	assert.Equal(t, 2, tournament.Standing.Page)
+	require.Len(t, tournament.Standing.Players, 1)
+
+	player := tournament.Standing.Players[0]
+	assert.Equal(t, 11, player.Rank)
+	assert.Equal(t, &lichess.TournamentSheet{Scores: "22020", Fire: true}, player.Sheet)
+}
+
+func TestTournamentsService_GetTournament_notFound(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/tournament/abcdefgh", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := client.Tournaments.GetTournament(context.Background(), "abcdefgh", nil)
+	require.ErrorIs(t, err, lichess.ErrNotFound)
+}
+
+func TestTournamentsService_GetResults(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/tournament/abcdefgh/results", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		assert.Equal(t, url.Values{"nb": {"2"}, "sheet": {"true"}}, r.URL.Query())
+
+		_, _ = w.Write([]byte(`{"rank":1,"score":42,"username":"alice","performance":2100,"sheet":{"scores":"5544"}}
+{"rank":2,"score":30,"username":"bob","team":"coders"}
+`))
+	})
+
+	nb, sheet := 2, true
+
+	results, _, err := client.Tournaments.GetResults(context.Background(), "abcdefgh",
+		&lichess.GetResultsOptions{Nb: &nb, Sheet: &sheet})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "alice", results[0].Username)
+	assert.Equal(t, 2100, results[0].Performance)
+	assert.Equal(t, "5544", results[0].Sheet.Scores)
+	assert.Equal(t, "coders", *results[1].Team)
+}
+
+func TestTournamentsService_Results(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/tournament/abcdefgh/results", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"rank":1,"username":"alice"}
+{"rank":2,"username":"bob"}
+{"rank":3,"username":"carol"}
+`))
+	})
+
+	var got []string
+	for result, err := range client.Tournaments.Results(context.Background(), "abcdefgh", nil) {
+		require.NoError(t, err)
+		got = append(got, result.Username)
+		if result.Rank == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"alice", "bob"}, got)
+}
+
+func TestTournamentsService_GetTeamStanding(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/tournament/abcdefgh/teams", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "abcdefgh",
+			"teams": [{"rank": 1, "id": "coders", "score": 80, "players": [{"user": {"id": "alice"}, "score": 42}]}]
+		}`))
+	})
+
+	standing, _, err := client.Tournaments.GetTeamStanding(context.Background(), "abcdefgh")
+	require.NoError(t, err)
+	require.Len(t, standing.Teams, 1)
+	assert.Equal(t, "coders", standing.Teams[0].Id)
+	require.Len(t, standing.Teams[0].Players, 1)
+	assert.Equal(t, "alice", standing.Teams[0].Players[0].User.Id)
+	assert.Equal(t, 42, standing.Teams[0].Players[0].Score)
+}
+
+func TestTournamentsService_ExportGames(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/tournament/abcdefgh/games", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Accept"))
+		assert.Equal(t, url.Values{"player": {"alice"}, "moves": {"false"}}, r.URL.Query())
+
+		_, _ = w.Write([]byte(`{"id":"game1","tournament":"abcdefgh"}
+{"id":"game2","tournament":"abcdefgh"}
+`))
+	})
+
+	player, moves := "alice", false
+	opts := &lichess.ExportTournamentGamesOptions{
+		ExportOptions: lichess.ExportOptions{Moves: &moves},
+		Player:        &player,
+	}
+
+	games, _, err := client.Tournaments.ExportGames(context.Background(), "abcdefgh", opts)
+	require.NoError(t, err)
+	require.Len(t, games, 2)
+	assert.Equal(t, "abcdefgh", *games[0].Tournament)
+
+	var ids []string
+	for game, err := range client.Tournaments.Games(context.Background(), "abcdefgh", opts) {
+		require.NoError(t, err)
+		ids = append(ids, game.Id)
+	}
+
+	assert.Equal(t, []string{"game1", "game2"}, ids)
+}
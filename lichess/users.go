@@ -1,8 +1,300 @@
 package lichess
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// UsersService handles communication with the user related
+// methods of the Lichess API.
+//
+// Lichess API docs: https://lichess.org/api#tag/Users
+type UsersService service
+
+// LightUser represents a Lichess user, with just the basic information.
 type LightUser struct {
 	Id     string  `json:"id,omitempty"`
 	Name   string  `json:"name,omitempty"`
 	Title  *string `json:"title,omitempty"`
+	Flair  *string `json:"flair,omitempty"`
 	Patron bool    `json:"patron,omitempty"`
 }
+
+// User represents a Lichess user.
+type User struct {
+	Id           string        `json:"id,omitempty"`
+	Username     string        `json:"username,omitempty"`
+	Perfs        *UserPerfs    `json:"perfs,omitempty"`
+	Title        *string       `json:"title,omitempty"`
+	Flair        *string       `json:"flair,omitempty"`
+	CreatedAt    int64         `json:"createdAt,omitempty"`
+	Disabled     bool          `json:"disabled,omitempty"`
+	TosViolation bool          `json:"tosViolation,omitempty"`
+	Profile      *UserProfile  `json:"profile,omitempty"`
+	SeenAt       int64         `json:"seenAt,omitempty"`
+	Patron       bool          `json:"patron,omitempty"`
+	Verified     bool          `json:"verified,omitempty"`
+	PlayTime     *UserPlayTime `json:"playTime,omitempty"`
+	Url          string        `json:"url,omitempty"`
+	Playing      *string       `json:"playing,omitempty"` // URL of the game being played, if any.
+	Count        *UserCount    `json:"count,omitempty"`
+	Streaming    bool          `json:"streaming,omitempty"`
+	Followable   bool          `json:"followable,omitempty"`
+	Following    bool          `json:"following,omitempty"`
+	Blocking     bool          `json:"blocking,omitempty"`
+	FollowsYou   bool          `json:"followsYou,omitempty"`
+}
+
+// UserPerfs represents the performance of a Lichess user,
+// for every speed, variant and puzzle mode played.
+type UserPerfs struct {
+	UltraBullet    *UserPerf `json:"ultraBullet,omitempty"`
+	Bullet         *UserPerf `json:"bullet,omitempty"`
+	Blitz          *UserPerf `json:"blitz,omitempty"`
+	Rapid          *UserPerf `json:"rapid,omitempty"`
+	Classical      *UserPerf `json:"classical,omitempty"`
+	Correspondence *UserPerf `json:"correspondence,omitempty"`
+	Chess960       *UserPerf `json:"chess960,omitempty"`
+	Crazyhouse     *UserPerf `json:"crazyhouse,omitempty"`
+	Antichess      *UserPerf `json:"antichess,omitempty"`
+	Atomic         *UserPerf `json:"atomic,omitempty"`
+	Horde          *UserPerf `json:"horde,omitempty"`
+	KingOfTheHill  *UserPerf `json:"kingOfTheHill,omitempty"`
+	RacingKings    *UserPerf `json:"racingKings,omitempty"`
+	ThreeCheck     *UserPerf `json:"threeCheck,omitempty"`
+	Puzzle         *UserPerf `json:"puzzle,omitempty"`
+
+	Storm  *UserPuzzleModePerf `json:"storm,omitempty"`
+	Racer  *UserPuzzleModePerf `json:"racer,omitempty"`
+	Streak *UserPuzzleModePerf `json:"streak,omitempty"`
+}
+
+// UserPerf represents the performance of a Lichess user
+// in a given speed, variant or puzzles.
+type UserPerf struct {
+	Games  int  `json:"games,omitempty"`
+	Rating int  `json:"rating,omitempty"`
+	Rd     int  `json:"rd,omitempty"`
+	Prog   int  `json:"prog,omitempty"`
+	Prov   bool `json:"prov,omitempty"`
+}
+
+// UserPuzzleModePerf represents the performance of a Lichess
+// user in a puzzle mode, like Puzzle Storm or Puzzle Racer.
+type UserPuzzleModePerf struct {
+	Runs  int `json:"runs,omitempty"`
+	Score int `json:"score,omitempty"`
+}
+
+// UserProfile represents the profile of a Lichess user.
+type UserProfile struct {
+	Flag       *string `json:"flag,omitempty"`
+	Location   *string `json:"location,omitempty"`
+	Bio        *string `json:"bio,omitempty"`
+	RealName   *string `json:"realName,omitempty"`
+	FideRating *int    `json:"fideRating,omitempty"`
+	UscfRating *int    `json:"uscfRating,omitempty"`
+	EcfRating  *int    `json:"ecfRating,omitempty"`
+	CfcRating  *int    `json:"cfcRating,omitempty"`
+	DsbRating  *int    `json:"dsbRating,omitempty"`
+	Links      *string `json:"links,omitempty"`
+}
+
+// UserPlayTime represents the time spent by a Lichess user
+// playing and on TV, in seconds.
+type UserPlayTime struct {
+	Total int `json:"total,omitempty"`
+	TV    int `json:"tv,omitempty"`
+}
+
+// UserCount represents the game counters of a Lichess user.
+type UserCount struct {
+	All      int `json:"all,omitempty"`
+	Rated    int `json:"rated,omitempty"`
+	AI       int `json:"ai,omitempty"`
+	Draw     int `json:"draw,omitempty"`
+	DrawH    int `json:"drawH,omitempty"`
+	Loss     int `json:"loss,omitempty"`
+	LossH    int `json:"lossH,omitempty"`
+	Win      int `json:"win,omitempty"`
+	WinH     int `json:"winH,omitempty"`
+	Bookmark int `json:"bookmark,omitempty"`
+	Playing  int `json:"playing,omitempty"`
+	Import   int `json:"import,omitempty"`
+	Me       int `json:"me,omitempty"`
+}
+
+// UserStatus represents the real-time status of a Lichess user.
+type UserStatus struct {
+	LightUser
+	Online    bool    `json:"online,omitempty"`
+	Playing   bool    `json:"playing,omitempty"`
+	PlayingId *string `json:"playingId,omitempty"`
+	Streaming bool    `json:"streaming,omitempty"`
+}
+
+// GetUser gets the public profile of the given username.
+// Find more details at https://lichess.org/api#tag/Users/operation/apiUser.
+func (s *UsersService) GetUser(ctx context.Context, username string) (*User, *Response, error) {
+	u := fmt.Sprintf("api/user/%v", username)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user *User
+	resp, err := s.client.Do(req, &user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+// GetUsersByIds gets the public profile of up to 300 users, by their identifiers.
+// Users that don't exist, or are closed, are omitted.
+// Find more details at https://lichess.org/api#tag/Users/operation/apiUsers.
+func (s *UsersService) GetUsersByIds(ctx context.Context, ids []string) ([]*User, *Response, error) {
+	u := "api/users"
+
+	req, err := s.client.NewRequestWithBody(ctx, http.MethodPost, u, RequestBody{
+		Bytes: strings.NewReader(strings.Join(ids, ",")),
+		Type:  "text/plain",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// GetUsersStatusOptions specifies parameters for
+// UsersService.GetUsersStatus method.
+type GetUsersStatusOptions struct {
+	// WithGameIds also returns the identifier of the game being played, if any.
+	WithGameIds *bool `url:"withGameIds,omitempty"`
+}
+
+// getUsersStatusOptions adds the required ids to GetUsersStatusOptions.
+type getUsersStatusOptions struct {
+	Ids                    []string `url:"ids,comma"`
+	*GetUsersStatusOptions `url:",omitempty"`
+}
+
+// GetUsersStatus gets the real-time status of up to 100 users, by their identifiers.
+// Find more details at https://lichess.org/api#tag/Users/operation/apiUsersStatus.
+func (s *UsersService) GetUsersStatus(
+	ctx context.Context,
+	ids []string,
+	opts *GetUsersStatusOptions,
+) ([]*UserStatus, *Response, error) {
+	u, err := addOptions("api/users/status", getUsersStatusOptions{Ids: ids, GetUsersStatusOptions: opts})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statuses []*UserStatus
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statuses, resp, nil
+}
+
+// AutocompleteOptions specifies parameters for UsersService.AutocompleteUsernames
+// and UsersService.AutocompleteUsers methods.
+type AutocompleteOptions struct {
+	// Friend returns followed players matching the term first, if any.
+	Friend *bool `url:"friend,omitempty"`
+}
+
+// autocompleteOptions adds the required term and
+// the response format to AutocompleteOptions.
+type autocompleteOptions struct {
+	Term                 string `url:"term"`
+	Object               bool   `url:"object,omitempty"`
+	*AutocompleteOptions `url:",omitempty"`
+}
+
+// AutocompleteUser represents a Lichess user returned by
+// the UsersService.AutocompleteUsers method.
+type AutocompleteUser struct {
+	LightUser
+	Online bool `json:"online,omitempty"`
+}
+
+// AutocompleteUsernames gets the usernames starting with the given term,
+// which must be at least 3 characters long.
+// Find more details at https://lichess.org/api#tag/Users/operation/apiPlayerAutocomplete.
+func (s *UsersService) AutocompleteUsernames(
+	ctx context.Context,
+	term string,
+	opts *AutocompleteOptions,
+) ([]string, *Response, error) {
+	u, err := addOptions("api/player/autocomplete", autocompleteOptions{Term: term, AutocompleteOptions: opts})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var usernames []string
+	resp, err := s.client.Do(req, &usernames)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usernames, resp, nil
+}
+
+// AutocompleteUsers gets the users whose username starts with the given term,
+// which must be at least 3 characters long.
+// Equivalent to [UsersService.AutocompleteUsernames] but returns [AutocompleteUser].
+// Find more details at https://lichess.org/api#tag/Users/operation/apiPlayerAutocomplete.
+func (s *UsersService) AutocompleteUsers(
+	ctx context.Context,
+	term string,
+	opts *AutocompleteOptions,
+) ([]*AutocompleteUser, *Response, error) {
+	u, err := addOptions("api/player/autocomplete", autocompleteOptions{
+		Term:                term,
+		Object:              true,
+		AutocompleteOptions: opts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Result []*AutocompleteUser `json:"result"`
+	}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Result, resp, nil
+}
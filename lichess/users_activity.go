@@ -0,0 +1,131 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// UserActivity represents the activity of a Lichess user during a given interval,
+// usually a day. Only the kinds of activity that happened are present.
+type UserActivity struct {
+	Interval struct {
+		Start int64 `json:"start,omitempty"`
+		End   int64 `json:"end,omitempty"`
+	} `json:"interval,omitempty"`
+	// Games holds the results of the games played, keyed by perf type (e.g. "blitz").
+	Games               map[string]*UserActivityScore   `json:"games,omitempty"`
+	Puzzles             *UserActivityPuzzles            `json:"puzzles,omitempty"`
+	Tournaments         *UserActivityTournaments        `json:"tournaments,omitempty"`
+	CorrespondenceMoves *UserActivityCorrespondence     `json:"correspondenceMoves,omitempty"`
+	CorrespondenceEnds  *UserActivityCorrespondenceEnds `json:"correspondenceEnds,omitempty"`
+	Follows             *UserActivityFollows            `json:"follows,omitempty"`
+	Teams               []*UserActivityTeam             `json:"teams,omitempty"`
+	Patron              *struct {
+		Months int `json:"months,omitempty"`
+	} `json:"patron,omitempty"`
+}
+
+// UserActivityScore represents the results of a Lichess user during an activity interval.
+type UserActivityScore struct {
+	Win  int `json:"win,omitempty"`
+	Loss int `json:"loss,omitempty"`
+	Draw int `json:"draw,omitempty"`
+	RP   *struct {
+		Before int `json:"before,omitempty"`
+		After  int `json:"after,omitempty"`
+	} `json:"rp,omitempty"` // Rating progress.
+}
+
+// UserActivityPuzzles represents the puzzles solved by a Lichess user during an activity interval.
+type UserActivityPuzzles struct {
+	Score *UserActivityScore `json:"score,omitempty"`
+}
+
+// UserActivityTournaments represents the tournaments played
+// by a Lichess user during an activity interval.
+type UserActivityTournaments struct {
+	Nb   int                       `json:"nb,omitempty"`
+	Best []*UserActivityTournament `json:"best,omitempty"`
+}
+
+// UserActivityTournament represents a tournament played by a Lichess user.
+type UserActivityTournament struct {
+	Tournament struct {
+		Id   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+	} `json:"tournament,omitempty"`
+	NbGames     int `json:"nbGames,omitempty"`
+	Score       int `json:"score,omitempty"`
+	Rank        int `json:"rank,omitempty"`
+	RankPercent int `json:"rankPercent,omitempty"`
+}
+
+// UserActivityCorrespondence represents the correspondence moves
+// played by a Lichess user during an activity interval.
+type UserActivityCorrespondence struct {
+	Nb    int                               `json:"nb,omitempty"`
+	Games []*UserActivityCorrespondenceGame `json:"games,omitempty"`
+}
+
+// UserActivityCorrespondenceEnds represents the correspondence games
+// finished by a Lichess user during an activity interval.
+type UserActivityCorrespondenceEnds struct {
+	Score *UserActivityScore                `json:"score,omitempty"`
+	Games []*UserActivityCorrespondenceGame `json:"games,omitempty"`
+}
+
+// UserActivityCorrespondenceGame represents a correspondence game played by a Lichess user.
+type UserActivityCorrespondenceGame struct {
+	Id       string      `json:"id,omitempty"`
+	Color    string      `json:"color,omitempty"`
+	Url      string      `json:"url,omitempty"`
+	Variant  GameVariant `json:"variant,omitempty"`
+	Speed    GameSpeed   `json:"speed,omitempty"`
+	Perf     string      `json:"perf,omitempty"`
+	Rated    bool        `json:"rated,omitempty"`
+	Opponent struct {
+		User   string `json:"user,omitempty"`
+		Rating int    `json:"rating,omitempty"`
+	} `json:"opponent,omitempty"`
+}
+
+// UserActivityFollows represents the users followed by, and
+// following, a Lichess user during an activity interval.
+type UserActivityFollows struct {
+	In  *UserActivityFollowList `json:"in,omitempty"`
+	Out *UserActivityFollowList `json:"out,omitempty"`
+}
+
+// UserActivityFollowList represents a list of user identifiers, which might
+// be truncated, in which case Nb holds the total number of users.
+type UserActivityFollowList struct {
+	Ids []string `json:"ids,omitempty"`
+	Nb  int      `json:"nb,omitempty"`
+}
+
+// UserActivityTeam represents a team joined by a Lichess user.
+type UserActivityTeam struct {
+	Url   string  `json:"url,omitempty"`
+	Name  string  `json:"name,omitempty"`
+	Flair *string `json:"flair,omitempty"`
+}
+
+// GetActivity gets the recent activity of the given username, most recent first.
+// Find more details at https://lichess.org/api#tag/Users/operation/apiUserActivity.
+func (s *UsersService) GetActivity(ctx context.Context, username string) ([]*UserActivity, *Response, error) {
+	u := fmt.Sprintf("api/user/%v/activity", username)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var activity []*UserActivity
+	resp, err := s.client.Do(req, &activity)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return activity, resp, nil
+}
@@ -0,0 +1,52 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsersService_GetActivity(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/user/chucknorris/activity", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{
+				"interval": {"start": 1700000000000, "end": 1700086400000},
+				"games": {"blitz": {"win": 3, "loss": 1, "draw": 0, "rp": {"before": 2500, "after": 2510}}},
+				"puzzles": {"score": {"win": 5}},
+				"tournaments": {"nb": 1, "best": [{"tournament": {"id": "abc", "name": "Hourly"}, "rank": 2}]},
+				"follows": {"in": {"ids": ["alice"], "nb": 12}},
+				"teams": [{"url": "https://lichess.org/team/coders", "name": "Coders"}]
+			},
+			{"interval": {"start": 1699913600000, "end": 1700000000000}}
+		]`))
+	})
+
+	activity, _, err := client.Users.GetActivity(context.Background(), "chucknorris")
+	require.NoError(t, err)
+	require.Len(t, activity, 2)
+
+	day := activity[0]
+	assert.Equal(t, int64(1700000000000), day.Interval.Start)
+	require.Contains(t, day.Games, "blitz")
+	assert.Equal(t, 3, day.Games["blitz"].Win)
+	require.NotNil(t, day.Games["blitz"].RP)
+	assert.Equal(t, 2510, day.Games["blitz"].RP.After)
+	assert.Equal(t, 5, day.Puzzles.Score.Win)
+	require.Len(t, day.Tournaments.Best, 1)
+	assert.Equal(t, "Hourly", day.Tournaments.Best[0].Tournament.Name)
+	assert.Equal(t, []string{"alice"}, day.Follows.In.Ids)
+	assert.Equal(t, 12, day.Follows.In.Nb)
+	assert.Nil(t, day.Follows.Out)
+	require.Len(t, day.Teams, 1)
+	assert.Equal(t, "Coders", day.Teams[0].Name)
+
+	// Only the kinds of activity that happened are present.
+	assert.Empty(t, activity[1].Games)
+	assert.Nil(t, activity[1].Puzzles)
+}
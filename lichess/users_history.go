@@ -0,0 +1,54 @@
+package lichess
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RatingHistory represents the rating history of a Lichess user in a given perf.
+type RatingHistory struct {
+	Name   string                `json:"name,omitempty"` // Display name of the perf, e.g. "Bullet".
+	Points []*RatingHistoryPoint `json:"points,omitempty"`
+}
+
+// RatingHistoryPoint represents the rating of a Lichess user at a given day.
+type RatingHistoryPoint struct {
+	Date   time.Time
+	Rating int
+}
+
+// UnmarshalJSON decodes the [year, month, day, rating] tuples sent by Lichess,
+// where months are zero-based (i.e. January is 0).
+func (p *RatingHistoryPoint) UnmarshalJSON(data []byte) error {
+	var tuple [4]int
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return err
+	}
+
+	p.Date = time.Date(tuple[0], time.Month(tuple[1]+1), tuple[2], 0, 0, 0, 0, time.UTC)
+	p.Rating = tuple[3]
+
+	return nil
+}
+
+// GetRatingHistory gets the rating history of the given username, for every perf.
+// Find more details at https://lichess.org/api#tag/Users/operation/apiUserRatingHistory.
+func (s *UsersService) GetRatingHistory(ctx context.Context, username string) ([]*RatingHistory, *Response, error) {
+	u := fmt.Sprintf("api/user/%v/rating-history", username)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var history []*RatingHistory
+	resp, err := s.client.Do(req, &history)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return history, resp, nil
+}
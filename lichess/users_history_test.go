@@ -0,0 +1,50 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsersService_GetRatingHistory(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/user/chucknorris/rating-history", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"name":"Bullet","points":[[2011,0,8,1472],[2011,11,31,1500]]},
+			{"name":"Blitz","points":[]}
+		]`))
+	})
+
+	history, _, err := client.Users.GetRatingHistory(context.Background(), "chucknorris")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	assert.Equal(t, "Bullet", history[0].Name)
+	require.Len(t, history[0].Points, 2)
+	// Months are zero-based.
+	assert.Equal(t, time.Date(2011, time.January, 8, 0, 0, 0, 0, time.UTC), history[0].Points[0].Date)
+	assert.Equal(t, 1472, history[0].Points[0].Rating)
+	assert.Equal(t, time.Date(2011, time.December, 31, 0, 0, 0, 0, time.UTC), history[0].Points[1].Date)
+	assert.Equal(t, 1500, history[0].Points[1].Rating)
+
+	assert.Equal(t, "Blitz", history[1].Name)
+	assert.Empty(t, history[1].Points)
+}
+
+func TestUsersService_GetRatingHistory_malformedPoint(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/user/chucknorris/rating-history", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"Bullet","points":[{"rating":1500}]}]`))
+	})
+
+	_, _, err := client.Users.GetRatingHistory(context.Background(), "chucknorris")
+	require.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// maxLeaderboardSize is the maximum number of
+// players of a leaderboard returned by Lichess.
+const maxLeaderboardSize = 200
+
+// Leaderboards represents the top 10 players of every Lichess perf.
+type Leaderboards struct {
+	UltraBullet   []*LeaderboardUser `json:"ultraBullet,omitempty"`
+	Bullet        []*LeaderboardUser `json:"bullet,omitempty"`
+	Blitz         []*LeaderboardUser `json:"blitz,omitempty"`
+	Rapid         []*LeaderboardUser `json:"rapid,omitempty"`
+	Classical     []*LeaderboardUser `json:"classical,omitempty"`
+	Chess960      []*LeaderboardUser `json:"chess960,omitempty"`
+	Crazyhouse    []*LeaderboardUser `json:"crazyhouse,omitempty"`
+	Antichess     []*LeaderboardUser `json:"antichess,omitempty"`
+	Atomic        []*LeaderboardUser `json:"atomic,omitempty"`
+	Horde         []*LeaderboardUser `json:"horde,omitempty"`
+	KingOfTheHill []*LeaderboardUser `json:"kingOfTheHill,omitempty"`
+	RacingKings   []*LeaderboardUser `json:"racingKings,omitempty"`
+	ThreeCheck    []*LeaderboardUser `json:"threeCheck,omitempty"`
+}
+
+// LeaderboardUser represents a Lichess user in a leaderboard.
+type LeaderboardUser struct {
+	Id       string  `json:"id,omitempty"`
+	Username string  `json:"username,omitempty"`
+	Title    *string `json:"title,omitempty"`
+	Flair    *string `json:"flair,omitempty"`
+	Patron   bool    `json:"patron,omitempty"`
+	Online   bool    `json:"online,omitempty"`
+	// Perfs holds the rating of the user in the perf of the
+	// leaderboard, keyed by perf type (e.g. "blitz").
+	Perfs map[string]*LeaderboardPerf `json:"perfs,omitempty"`
+}
+
+// Perf returns the rating of the user in the given perf, or nil if not present.
+func (u *LeaderboardUser) Perf(perf PerfType) *LeaderboardPerf {
+	if perf == nil {
+		return nil
+	}
+
+	return u.Perfs[perf.perfType()]
+}
+
+// LeaderboardPerf represents the rating of a Lichess user in a leaderboard.
+type LeaderboardPerf struct {
+	Rating   int `json:"rating,omitempty"`
+	Progress int `json:"progress,omitempty"`
+}
+
+// GetTopPlayers gets the top 10 players of every perf.
+// Find more details at https://lichess.org/api#tag/Users/operation/player.
+func (s *UsersService) GetTopPlayers(ctx context.Context) (*Leaderboards, *Response, error) {
+	u := "api/player"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var leaderboards *Leaderboards
+	resp, err := s.client.Do(req, &leaderboards)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return leaderboards, resp, nil
+}
+
+// GetLeaderboard gets the top nb players (from 1 to 200) of the given perf, which is either
+// a [GameSpeed] (except Correspondence) or a [GameVariant] (except Standard and FromPosition).
+// Find more details at https://lichess.org/api#tag/Users/operation/playerTopNbPerfType.
+func (s *UsersService) GetLeaderboard(
+	ctx context.Context,
+	nb int,
+	perf PerfType,
+) ([]*LeaderboardUser, *Response, error) {
+	if nb < 1 || nb > maxLeaderboardSize {
+		return nil, nil, fmt.Errorf("invalid leaderboard size: %d, must be between 1 and %d", nb, maxLeaderboardSize)
+	}
+
+	if err := validPerfType(perf); err != nil {
+		return nil, nil, err
+	}
+
+	// There are no leaderboards for correspondence games.
+	if perf == Correspondence {
+		return nil, nil, fmt.Errorf("invalid leaderboard perf type: %v", perf)
+	}
+
+	u := fmt.Sprintf("api/player/top/%d/%v", nb, perf.perfType())
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// This endpoint requires a specific version of the response format.
+	req.Header.Set("Accept", "application/vnd.lichess.v3+json")
+
+	var leaderboard struct {
+		Users []*LeaderboardUser `json:"users"`
+	}
+	resp, err := s.client.Do(req, &leaderboard)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return leaderboard.Users, resp, nil
+}
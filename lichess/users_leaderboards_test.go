@@ -0,0 +1,90 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestUsersService_GetTopPlayers(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/player", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"bullet": [{"id": "alice", "username": "Alice", "perfs": {"bullet": {"rating": 3000, "progress": 5}}}],
+			"chess960": [{"id": "bob", "username": "Bob", "online": true, "perfs": {"chess960": {"rating": 2400}}}]
+		}`))
+	})
+
+	leaderboards, _, err := client.Users.GetTopPlayers(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, leaderboards.Bullet, 1)
+	require.NotNil(t, leaderboards.Bullet[0].Perf(lichess.Bullet))
+	assert.Equal(t, 3000, leaderboards.Bullet[0].Perf(lichess.Bullet).Rating)
+	assert.Nil(t, leaderboards.Bullet[0].Perf(lichess.Blitz))
+
+	require.Len(t, leaderboards.Chess960, 1)
+	assert.True(t, leaderboards.Chess960[0].Online)
+	assert.Equal(t, 2400, leaderboards.Chess960[0].Perf(lichess.Chess960).Rating)
+	assert.Empty(t, leaderboards.Blitz)
+}
+
+func TestUsersService_GetLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/player/top/2/atomic", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/vnd.lichess.v3+json", r.Header.Get("Accept"))
+
+		_, _ = w.Write([]byte(`{"users": [
+			{"id": "alice", "username": "Alice", "perfs": {"atomic": {"rating": 2600}}},
+			{"id": "bob", "username": "Bob", "perfs": {"atomic": {"rating": 2500, "progress": -7}}}
+		]}`))
+	})
+
+	users, _, err := client.Users.GetLeaderboard(context.Background(), 2, lichess.Atomic)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+
+	assert.Equal(t, "Alice", users[0].Username)
+	assert.Equal(t, 2600, users[0].Perf(lichess.Atomic).Rating)
+	assert.Equal(t, -7, users[1].Perf(lichess.Atomic).Progress)
+}
+
+func TestUsersService_GetLeaderboard_invalid(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		nb   int
+		perf lichess.PerfType
+	}{
+		"zero players":   {nb: 0, perf: lichess.Blitz},
+		"too many":       {nb: 201, perf: lichess.Blitz},
+		"negative":       {nb: -1, perf: lichess.Blitz},
+		"standard":       {nb: 10, perf: lichess.Standard},
+		"correspondence": {nb: 10, perf: lichess.Correspondence},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc("/", func(_ http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %v %v", r.Method, r.URL)
+			})
+
+			users, resp, err := client.Users.GetLeaderboard(context.Background(), tc.nb, tc.perf)
+			require.Error(t, err)
+			assert.Nil(t, users)
+			assert.Nil(t, resp)
+		})
+	}
+}
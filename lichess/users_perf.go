@@ -0,0 +1,139 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PerfStats represents the performance statistics of a Lichess user in a given perf.
+type PerfStats struct {
+	User       *LightUser     `json:"user,omitempty"`
+	Perf       *PerfStatsPerf `json:"perf,omitempty"`
+	Rank       *int           `json:"rank,omitempty"`       // Nil if the user isn't ranked.
+	Percentile *float64       `json:"percentile,omitempty"` // Percentage of players with a lower rating.
+	Stat       *PerfStatsStat `json:"stat,omitempty"`
+}
+
+// PerfStatsPerf represents the current rating of a Lichess user in a given perf.
+type PerfStatsPerf struct {
+	Glicko *struct {
+		Rating      float64 `json:"rating,omitempty"`
+		Deviation   float64 `json:"deviation,omitempty"`
+		Provisional bool    `json:"provisional,omitempty"`
+	} `json:"glicko,omitempty"`
+	Nb       int `json:"nb,omitempty"`       // Number of rated games.
+	Progress int `json:"progress,omitempty"` // Rating progress over the last twelve games.
+}
+
+// PerfStatsStat represents the detailed statistics of a Lichess user in a given perf.
+type PerfStatsStat struct {
+	PerfType *struct {
+		Key  string `json:"key,omitempty"`
+		Name string `json:"name,omitempty"`
+	} `json:"perfType,omitempty"`
+	Highest      *PerfStatsRating       `json:"highest,omitempty"`
+	Lowest       *PerfStatsRating       `json:"lowest,omitempty"`
+	BestWins     *PerfStatsResults      `json:"bestWins,omitempty"`
+	WorstLosses  *PerfStatsResults      `json:"worstLosses,omitempty"`
+	Count        *PerfStatsCount        `json:"count,omitempty"`
+	ResultStreak *PerfStatsResultStreak `json:"resultStreak,omitempty"`
+	PlayStreak   *PerfStatsPlayStreak   `json:"playStreak,omitempty"`
+}
+
+// PerfStatsRating represents a rating reached by a Lichess user at a given game.
+type PerfStatsRating struct {
+	Rating int       `json:"int,omitempty"`
+	At     time.Time `json:"at,omitempty"`
+	GameId string    `json:"gameId,omitempty"`
+}
+
+// PerfStatsResults represents a list of notable game results of a Lichess user.
+type PerfStatsResults struct {
+	Results []*PerfStatsResult `json:"results,omitempty"`
+}
+
+// PerfStatsResult represents a notable game result of a Lichess user.
+type PerfStatsResult struct {
+	OpRating int        `json:"opRating,omitempty"`
+	OpId     *LightUser `json:"opId,omitempty"`
+	At       time.Time  `json:"at,omitempty"`
+	GameId   string     `json:"gameId,omitempty"`
+}
+
+// PerfStatsCount represents the game counters of a Lichess user in a given perf.
+type PerfStatsCount struct {
+	All         int     `json:"all,omitempty"`
+	Rated       int     `json:"rated,omitempty"`
+	Win         int     `json:"win,omitempty"`
+	Loss        int     `json:"loss,omitempty"`
+	Draw        int     `json:"draw,omitempty"`
+	Tour        int     `json:"tour,omitempty"`
+	Berserk     int     `json:"berserk,omitempty"`
+	OpAvg       float64 `json:"opAvg,omitempty"`
+	Seconds     int     `json:"seconds,omitempty"`
+	Disconnects int     `json:"disconnects,omitempty"`
+}
+
+// PerfStatsResultStreak represents the winning and losing streaks of a Lichess user.
+type PerfStatsResultStreak struct {
+	Win  *PerfStatsStreaks `json:"win,omitempty"`
+	Loss *PerfStatsStreaks `json:"loss,omitempty"`
+}
+
+// PerfStatsPlayStreak represents the playing streaks of a Lichess user, both in number
+// of games (Nb) and in time (Time, in seconds), as well as the date of the last game.
+type PerfStatsPlayStreak struct {
+	Nb       *PerfStatsStreaks `json:"nb,omitempty"`
+	Time     *PerfStatsStreaks `json:"time,omitempty"`
+	LastDate *time.Time        `json:"lastDate,omitempty"`
+}
+
+// PerfStatsStreaks represents the current and the longest streaks of a Lichess user.
+type PerfStatsStreaks struct {
+	Cur *PerfStatsStreak `json:"cur,omitempty"`
+	Max *PerfStatsStreak `json:"max,omitempty"`
+}
+
+// PerfStatsStreak represents a streak of a Lichess user, with its value
+// and the games where it started and ended, if any.
+type PerfStatsStreak struct {
+	V    int                  `json:"v,omitempty"`
+	From *PerfStatsStreakGame `json:"from,omitempty"`
+	To   *PerfStatsStreakGame `json:"to,omitempty"`
+}
+
+// PerfStatsStreakGame represents the game where a streak started or ended.
+type PerfStatsStreakGame struct {
+	At     time.Time `json:"at,omitempty"`
+	GameId string    `json:"gameId,omitempty"`
+}
+
+// GetPerfStats gets the performance statistics of the given username in the given perf,
+// which is either a [GameSpeed] or a [GameVariant] (except Standard and FromPosition).
+// Find more details at https://lichess.org/api#tag/Users/operation/apiUserPerf.
+func (s *UsersService) GetPerfStats(
+	ctx context.Context,
+	username string,
+	perf PerfType,
+) (*PerfStats, *Response, error) {
+	if err := validPerfType(perf); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("api/user/%v/perf/%v", username, perf.perfType())
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stats *PerfStats
+	resp, err := s.client.Do(req, &stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
@@ -0,0 +1,87 @@
+package lichess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestUsersService_GetPerfStats(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		perf lichess.PerfType
+		path string
+	}{
+		"speed":   {perf: lichess.Blitz, path: "blitz"},
+		"variant": {perf: lichess.Chess960, path: "chess960"},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc("GET /api/user/chucknorris/perf/"+tc.path, func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{
+					"user": {"name": "ChuckNorris"},
+					"perf": {"glicko": {"rating": 2500.5, "deviation": 45.2}, "nb": 100, "progress": -3},
+					"rank": 7,
+					"percentile": 99.9,
+					"stat": {
+						"highest": {"int": 2600, "at": "2021-05-01T10:00:00.000Z", "gameId": "abcdefgh"},
+						"count": {"all": 120, "rated": 100, "win": 80},
+						"resultStreak": {"win": {"cur": {"v": 3}, "max": {"v": 12}}}
+					}
+				}`))
+			})
+
+			stats, _, err := client.Users.GetPerfStats(context.Background(), "chucknorris", tc.perf)
+			require.NoError(t, err)
+
+			assert.Equal(t, "ChuckNorris", stats.User.Name)
+			require.NotNil(t, stats.Perf.Glicko)
+			assert.InDelta(t, 2500.5, stats.Perf.Glicko.Rating, 0.001)
+			assert.Equal(t, -3, stats.Perf.Progress)
+			require.NotNil(t, stats.Rank)
+			assert.Equal(t, 7, *stats.Rank)
+			require.NotNil(t, stats.Stat)
+			assert.Equal(t, 2600, stats.Stat.Highest.Rating)
+			assert.Equal(t, "abcdefgh", stats.Stat.Highest.GameId)
+			assert.Nil(t, stats.Stat.Lowest)
+			assert.Equal(t, 80, stats.Stat.Count.Win)
+			assert.Equal(t, 12, stats.Stat.ResultStreak.Win.Max.V)
+		})
+	}
+}
+
+func TestUsersService_GetPerfStats_invalidPerfType(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]lichess.PerfType{
+		"standard":      lichess.Standard,
+		"from position": lichess.FromPosition,
+		"unknown":       lichess.GameSpeed("hyperBullet"),
+	}
+
+	for name, perf := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := setup(t)
+			mux.HandleFunc("/", func(_ http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %v %v", r.Method, r.URL)
+			})
+
+			stats, resp, err := client.Users.GetPerfStats(context.Background(), "chucknorris", perf)
+			require.Error(t, err)
+			assert.Nil(t, stats)
+			assert.Nil(t, resp)
+		})
+	}
+}
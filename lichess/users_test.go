@@ -0,0 +1,145 @@
+package lichess_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/joanlopez/go-lichess/lichess"
+)
+
+func TestUsersService_GetUser(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/user/chucknorris", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "chucknorris",
+			"username": "ChuckNorris",
+			"title": "GM",
+			"perfs": {"blitz": {"games": 10, "rating": 2500, "rd": 45, "prog": 12}},
+			"playing": "https://lichess.org/abcdefgh/white",
+			"count": {"all": 10, "win": 10}
+		}`))
+	})
+
+	user, _, err := client.Users.GetUser(context.Background(), "chucknorris")
+	require.NoError(t, err)
+
+	assert.Equal(t, "chucknorris", user.Id)
+	assert.Equal(t, "ChuckNorris", user.Username)
+	require.NotNil(t, user.Title)
+	assert.Equal(t, "GM", *user.Title)
+	require.NotNil(t, user.Perfs)
+	require.NotNil(t, user.Perfs.Blitz)
+	assert.Equal(t, 2500, user.Perfs.Blitz.Rating)
+	assert.Nil(t, user.Perfs.Bullet)
+	require.NotNil(t, user.Playing)
+	assert.Equal(t, "https://lichess.org/abcdefgh/white", *user.Playing)
+	require.NotNil(t, user.Count)
+	assert.Equal(t, 10, user.Count.Win)
+}
+
+func TestUsersService_GetUser_notFound(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/user/nobody", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	user, _, err := client.Users.GetUser(context.Background(), "nobody")
+	require.ErrorIs(t, err, lichess.ErrNotFound)
+	assert.Nil(t, user)
+}
+
+func TestUsersService_GetUsersByIds(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("POST /api/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice,bob", string(body))
+
+		_, _ = w.Write([]byte(`[{"id":"alice"},{"id":"bob"}]`))
+	})
+
+	users, _, err := client.Users.GetUsersByIds(context.Background(), []string{"alice", "bob"})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "alice", users[0].Id)
+	assert.Equal(t, "bob", users[1].Id)
+}
+
+func TestUsersService_GetUsersStatus(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/users/status", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "alice,bob", r.URL.Query().Get("ids"))
+		assert.Equal(t, "true", r.URL.Query().Get("withGameIds"))
+
+		_, _ = w.Write([]byte(`[
+			{"id":"alice","name":"Alice","online":true,"playing":true,"playingId":"abcdefgh"},
+			{"id":"bob","name":"Bob"}
+		]`))
+	})
+
+	withGameIds := true
+	statuses, _, err := client.Users.GetUsersStatus(context.Background(), []string{"alice", "bob"},
+		&lichess.GetUsersStatusOptions{WithGameIds: &withGameIds})
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+
+	assert.Equal(t, "Alice", statuses[0].Name)
+	assert.True(t, statuses[0].Online)
+	require.NotNil(t, statuses[0].PlayingId)
+	assert.Equal(t, "abcdefgh", *statuses[0].PlayingId)
+	assert.False(t, statuses[1].Online)
+	assert.Nil(t, statuses[1].PlayingId)
+}
+
+func TestUsersService_AutocompleteUsernames(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/player/autocomplete", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "chu", r.URL.Query().Get("term"))
+		assert.False(t, r.URL.Query().Has("object"))
+		assert.False(t, r.URL.Query().Has("friend"))
+
+		_, _ = w.Write([]byte(`["chucknorris","chuck"]`))
+	})
+
+	usernames, _, err := client.Users.AutocompleteUsernames(context.Background(), "chu", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"chucknorris", "chuck"}, usernames)
+}
+
+func TestUsersService_AutocompleteUsers(t *testing.T) {
+	t.Parallel()
+
+	client, mux := setup(t)
+	mux.HandleFunc("GET /api/player/autocomplete", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "chu", r.URL.Query().Get("term"))
+		assert.Equal(t, "true", r.URL.Query().Get("object"))
+		assert.Equal(t, "true", r.URL.Query().Get("friend"))
+
+		_, _ = w.Write([]byte(`{"result":[{"id":"chucknorris","name":"ChuckNorris","online":true}]}`))
+	})
+
+	friend := true
+	users, _, err := client.Users.AutocompleteUsers(context.Background(), "chu",
+		&lichess.AutocompleteOptions{Friend: &friend})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "ChuckNorris", users[0].Name)
+	assert.True(t, users[0].Online)
+}